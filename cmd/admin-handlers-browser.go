@@ -0,0 +1,56 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/pkg/v3/policy"
+)
+
+// BrandingInfo carries operator-provided branding shown by a browser UI,
+// sourced from the 'browser' config subsystem (mc admin config set browser
+// branding_logo_url=... branding_color=...).
+type BrandingInfo struct {
+	LogoURL string `json:"logoURL"`
+	Color   string `json:"color"`
+}
+
+// BrandingInfoHandler - GET /minio/admin/v3/branding-info
+// Returns the operator-configured branding (logo URL, accent color) so a
+// browser UI can render it without hard-coding MinIO defaults.
+func (a adminAPIHandlers) BrandingInfoHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(BrandingInfo{
+		LogoURL: globalBrowserConfig.GetBrandingLogoURL(),
+		Color:   globalBrowserConfig.GetBrandingColor(),
+	})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/mux"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// Bucket access levels understood by {Set,Get}BucketAccessHandler. These are
+// a simplified emulation of S3's canned object ACLs, synthesized as ordinary
+// bucket policy statements rather than stored as a separate concept.
+const (
+	bucketAccessPrivate    = "private"
+	bucketAccessPublicRead = "public-read"
+	bucketAccessUploadOnly = "upload-only"
+)
+
+// accessStatementSID returns the stable statement ID used for the statement
+// this handler synthesizes for a given prefix, so that re-applying an access
+// level to the same prefix replaces the earlier statement instead of
+// accumulating duplicates.
+func accessStatementSID(prefix string) policy.ID {
+	return policy.ID(fmt.Sprintf("MinIOSimplifiedAccess-%s", prefix))
+}
+
+// setBucketAccessReq is the request body accepted by SetBucketAccessHandler.
+type setBucketAccessReq struct {
+	Prefix string `json:"prefix"`
+	Access string `json:"access"`
+}
+
+// SetBucketAccessHandler - POST /minio/admin/v3/set-bucket-access?bucket={bucket}
+// Synthesizes and applies the bucket policy statement(s) needed to make the
+// given prefix within bucket "private", "public-read" or "upload-only",
+// without requiring the caller to hand-write S3 policy JSON. The request
+// fails without applying any change if the synthesized statement would be
+// shadowed by a pre-existing explicit Deny statement on the same prefix.
+func (a adminAPIHandlers) SetBucketAccessHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ImportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	var req setBucketAccessReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	statements, existing, err := applyAccessStatement(ctx, bucket, req.Prefix, req.Access)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, APIError{
+			Code:           "XMinioAdminBucketAccessConflict",
+			HTTPStatusCode: http.StatusConflict,
+			Description:    err.Error(),
+		}, r.URL)
+		return
+	}
+
+	var updatedAt time.Time
+	if len(statements) == 0 {
+		if existing {
+			updatedAt, err = globalBucketMetadataSys.Delete(ctx, bucket, bucketPolicyConfig)
+		}
+	} else {
+		bp := policy.BucketPolicy{Version: policy.DefaultVersion, Statements: statements}
+		var configData []byte
+		configData, err = json.Marshal(bp)
+		if err == nil {
+			updatedAt, err = globalBucketMetadataSys.Update(ctx, bucket, bucketPolicyConfig, configData)
+		}
+	}
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook, mirroring PutBucketPolicyHandler/
+	// DeleteBucketPolicyHandler.
+	policyBytes, _ := json.Marshal(policy.BucketPolicy{Version: policy.DefaultVersion, Statements: statements})
+	if len(statements) == 0 {
+		policyBytes = nil
+	}
+	replLogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      madmin.SRBucketMetaTypePolicy,
+		Bucket:    bucket,
+		Policy:    policyBytes,
+		UpdatedAt: updatedAt,
+	}))
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// applyAccessStatement computes the full statement list that should be
+// written for bucket after applying access to prefix, replacing any
+// statement previously synthesized for that prefix. It returns an error,
+// without mutating anything, if the requested access level would be
+// shadowed by an existing explicit Deny statement on the same resource. The
+// second return value reports whether a bucket policy existed before this
+// call.
+func applyAccessStatement(ctx context.Context, bucket, prefix, access string) ([]policy.BPStatement, bool, error) {
+	var current []policy.BPStatement
+	existing := false
+	if bp, _, err := globalBucketMetadataSys.GetPolicyConfig(bucket); err == nil {
+		current = bp.Statements
+		existing = true
+	} else if !errors.As(err, &BucketPolicyNotFound{}) {
+		return nil, false, err
+	}
+
+	sid := accessStatementSID(prefix)
+	kept := make([]policy.BPStatement, 0, len(current))
+	for _, st := range current {
+		if st.SID != sid {
+			kept = append(kept, st)
+		}
+	}
+
+	if access == bucketAccessPrivate || access == "" {
+		return kept, existing, nil
+	}
+
+	var action policy.Action
+	switch access {
+	case bucketAccessPublicRead:
+		action = policy.GetObjectAction
+	case bucketAccessUploadOnly:
+		action = policy.PutObjectAction
+	default:
+		return nil, false, fmt.Errorf("unsupported access level %q", access)
+	}
+
+	resource := bucket + "/" + prefix + "*"
+
+	candidate := append(append([]policy.BPStatement{}, kept...), policy.BPStatement{
+		SID:       sid,
+		Effect:    policy.Allow,
+		Principal: policy.Principal{AWS: set.CreateStringSet("*")},
+		Actions:   policy.NewActionSet(action),
+		Resources: policy.NewResourceSet(policy.NewResource(resource)),
+	})
+
+	bp := policy.BucketPolicy{Version: policy.DefaultVersion, Statements: candidate}
+	if !bp.IsAllowed(policy.BucketPolicyArgs{
+		AccountName: "",
+		Action:      action,
+		BucketName:  bucket,
+		ObjectName:  prefix + "minio-access-probe",
+		IsOwner:     false,
+	}) {
+		return nil, false, fmt.Errorf("an existing Deny statement on bucket %q already blocks %s for prefix %q", bucket, action, prefix)
+	}
+
+	return candidate, existing, nil
+}
+
+// GetBucketAccessHandler - GET /minio/admin/v3/get-bucket-access?bucket={bucket}&prefix={prefix}
+// Returns the effective access level for the given prefix, as determined by
+// evaluating the bucket's current policy (however it was set) against an
+// anonymous principal. This allows callers to answer "is this prefix
+// public?" without having to parse and reason about raw policy JSON.
+func (a adminAPIHandlers) GetBucketAccessHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ExportBucketMetadataAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+	prefix := r.Form.Get("prefix")
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	bp, _, err := globalBucketMetadataSys.GetPolicyConfig(bucket)
+	if err != nil && !errors.As(err, &BucketPolicyNotFound{}) {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	access := bucketAccessPrivate
+	if bp != nil {
+		args := policy.BucketPolicyArgs{
+			AccountName: "",
+			BucketName:  bucket,
+			ObjectName:  prefix + "minio-access-probe",
+			IsOwner:     false,
+		}
+		args.Action = policy.GetObjectAction
+		canGet := bp.IsAllowed(args)
+		args.Action = policy.PutObjectAction
+		canPut := bp.IsAllowed(args)
+
+		switch {
+		case canGet && canPut:
+			access = "public-read-write"
+		case canGet:
+			access = bucketAccessPublicRead
+		case canPut:
+			access = bucketAccessUploadOnly
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Bucket string `json:"bucket"`
+		Prefix string `json:"prefix"`
+		Access string `json:"access"`
+	}{
+		Bucket: bucket,
+		Prefix: prefix,
+		Access: access,
+	})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
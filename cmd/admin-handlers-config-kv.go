@@ -167,7 +167,7 @@ func (a adminAPIHandlers) SetConfigKVHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	result, err := setConfigKV(ctx, objectAPI, kvBytes)
+	result, err := setConfigKV(ctx, objectAPI, kvBytes, cred.AccessKey)
 	if err != nil {
 		switch err.(type) {
 		case badConfigErr:
@@ -190,7 +190,7 @@ func (a adminAPIHandlers) SetConfigKVHandler(w http.ResponseWriter, r *http.Requ
 	writeSuccessResponseHeadersOnly(w)
 }
 
-func setConfigKV(ctx context.Context, objectAPI ObjectLayer, kvBytes []byte) (result setConfigResult, err error) {
+func setConfigKV(ctx context.Context, objectAPI ObjectLayer, kvBytes []byte, actor string) (result setConfigResult, err error) {
 	result.Cfg, err = readServerConfig(ctx, objectAPI, nil)
 	if err != nil {
 		return
@@ -226,7 +226,7 @@ func setConfigKV(ctx context.Context, objectAPI ObjectLayer, kvBytes []byte) (re
 	}
 
 	// Write the config input KV to history.
-	err = saveServerConfigHistory(ctx, objectAPI, kvBytes)
+	err = saveServerConfigHistory(ctx, objectAPI, kvBytes, actor)
 	return
 }
 
@@ -466,7 +466,7 @@ func (a adminAPIHandlers) SetConfigHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 	// Write to the config input KV to history.
-	if err = saveServerConfigHistory(ctx, objectAPI, kvBytes); err != nil {
+	if err = saveServerConfigHistory(ctx, objectAPI, kvBytes, cred.AccessKey); err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
 	}
@@ -146,7 +146,7 @@ func addOrUpdateIDPHandler(ctx context.Context, w http.ResponseWriter, r *http.R
 	}
 
 	// Write to the config input KV to history.
-	if err = saveServerConfigHistory(ctx, objectAPI, []byte(cfgData)); err != nil {
+	if err = saveServerConfigHistory(ctx, objectAPI, []byte(cfgData), cred.AccessKey); err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
 		return
 	}
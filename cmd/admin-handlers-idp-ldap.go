@@ -333,7 +333,7 @@ func (a adminAPIHandlers) AddServiceAccountLDAP(w http.ResponseWriter, r *http.R
 
 	// Call hook for cluster-replication if the service account is not for a
 	// root user.
-	if newCred.ParentUser != globalActiveCred.AccessKey {
+	if newCred.ParentUser != globalActiveCred().AccessKey {
 		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
 			Type: madmin.SRIAMItemSvcAcc,
 			SvcAccChange: &madmin.SRSvcAccChange{
@@ -0,0 +1,86 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// SetLogLevelHandler - sets the kinds of logs (e.g. "ERROR,WARNING,FATAL")
+// that are sent to configured log targets, effective immediately and
+// without a server restart. An empty or "ALL" level resets to logging
+// everything.
+func (a adminAPIHandlers) SetLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ConsoleLogAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	level := strings.ToUpper(strings.TrimSpace(r.Form.Get("level")))
+	if level == "" || level == string(madmin.LogKindAll) {
+		logger.SetLogMask(madmin.LogMaskAll)
+		writeSuccessResponseHeadersOnly(w)
+		return
+	}
+
+	var mask madmin.LogMask
+	for _, kind := range strings.Split(level, ",") {
+		mask |= madmin.LogKind(strings.TrimSpace(kind)).LogMask()
+	}
+
+	logger.SetLogMask(mask)
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetLogLevelHandler - returns the currently active log mask as a
+// comma-separated list of log kinds.
+func (a adminAPIHandlers) GetLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ConsoleLogAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	mask := logger.LogMask()
+	var kinds []string
+	for _, kind := range []madmin.LogKind{
+		madmin.LogKindFatal, madmin.LogKindWarning, madmin.LogKindError,
+		madmin.LogKindEvent, madmin.LogKindInfo,
+	} {
+		if mask.Contains(kind.LogMask()) {
+			kinds = append(kinds, string(kind))
+		}
+	}
+
+	data, err := json.Marshal(strings.Join(kinds, ","))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/minio/pkg/v3/policy"
+)
+
+// MultipartUploadInfo - a single in-progress multipart upload, aggregated
+// for the cluster-wide admin listing below.
+type MultipartUploadInfo struct {
+	Bucket    string    `json:"bucket"`
+	Object    string    `json:"object"`
+	UploadID  string    `json:"uploadId"`
+	Initiated time.Time `json:"initiated"`
+	AgeSecs   float64   `json:"ageSecs"`
+	Size      int64     `json:"size"`
+}
+
+// listAllMultipartUploads lists every in-progress multipart upload across
+// every bucket. Each upload's accumulated size is the sum of the parts
+// already uploaded for it.
+//
+// Only the first 10,000 parts (the S3 maximum per upload) of each upload are
+// summed, in line with the per-call maximum ListObjectParts already enforces
+// elsewhere in this API. Uploads are listed 10,000 per call, following the
+// continuation markers until every upload in the bucket has been listed.
+func listAllMultipartUploads(ctx context.Context, objectAPI ObjectLayer) ([]MultipartUploadInfo, error) {
+	buckets, err := objectAPI.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var uploads []MultipartUploadInfo
+	for _, bucket := range buckets {
+		keyMarker, uploadIDMarker := "", ""
+		for {
+			result, err := objectAPI.ListMultipartUploads(ctx, bucket.Name, "", keyMarker, uploadIDMarker, "", maxUploadsList)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, upload := range result.Uploads {
+				var size int64
+				parts, err := objectAPI.ListObjectParts(ctx, bucket.Name, upload.Object, upload.UploadID, 0, maxPartsList, ObjectOptions{})
+				if err != nil {
+					// The upload may have been completed or aborted concurrently
+					// with this listing - skip it rather than failing the whole
+					// cluster-wide report.
+					continue
+				}
+				for _, part := range parts.Parts {
+					size += part.Size
+				}
+
+				uploads = append(uploads, MultipartUploadInfo{
+					Bucket:    bucket.Name,
+					Object:    upload.Object,
+					UploadID:  upload.UploadID,
+					Initiated: upload.Initiated,
+					AgeSecs:   time.Since(upload.Initiated).Seconds(),
+					Size:      size,
+				})
+			}
+
+			if !result.IsTruncated {
+				break
+			}
+			keyMarker, uploadIDMarker = result.NextKeyMarker, result.NextUploadIDMarker
+		}
+	}
+
+	return uploads, nil
+}
+
+// ListMultipartUploadsHandler - GET /minio/admin/v3/list-multipart-uploads
+// Lists every in-progress multipart upload across every bucket, with its age
+// and the amount of data already uploaded for it, so stuck uploads can be
+// spotted without listing each bucket by hand.
+func (a adminAPIHandlers) ListMultipartUploadsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	uploads, err := listAllMultipartUploads(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(uploads)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// AbortMultipartUploadsResult - outcome of a bulk abort request.
+type AbortMultipartUploadsResult struct {
+	Aborted int `json:"aborted"`
+	Failed  int `json:"failed"`
+}
+
+// AbortMultipartUploadsHandler - POST /minio/admin/v3/abort-multipart-uploads?olderThan={duration}&bucket={bucket}
+// Bulk-aborts in-progress multipart uploads across the cluster, optionally
+// restricted to a single bucket and/or to uploads older than olderThan (a
+// Go duration string, e.g. "24h"). Either filter may be omitted; omitting
+// both aborts every in-progress upload cluster-wide.
+func (a adminAPIHandlers) AbortMultipartUploadsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	bucketFilter := r.Form.Get("bucket")
+
+	var cutoff time.Time
+	if olderThan := r.Form.Get("olderThan"); olderThan != "" {
+		dur, err := time.ParseDuration(olderThan)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+		cutoff = time.Now().Add(-dur)
+	}
+
+	uploads, err := listAllMultipartUploads(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	var aborted, failed int
+	for _, upload := range uploads {
+		if bucketFilter != "" && upload.Bucket != bucketFilter {
+			continue
+		}
+		if !cutoff.IsZero() && upload.Initiated.After(cutoff) {
+			continue
+		}
+		if err := objectAPI.AbortMultipartUpload(ctx, upload.Bucket, upload.Object, upload.UploadID, ObjectOptions{NoAuditLog: true}); err != nil {
+			failed++
+			continue
+		}
+		aborted++
+	}
+
+	data, err := json.Marshal(AbortMultipartUploadsResult{Aborted: aborted, Failed: failed})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-2026 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/auth"
+)
+
+// defaultRootCredGraceWindow is how long the previous root credential keeps
+// working after a rotation when the caller does not specify a window.
+const defaultRootCredGraceWindow = 24 * time.Hour
+
+// rootCredentialRotateReq is the (encrypted) body of RotateRootCredentials.
+type rootCredentialRotateReq struct {
+	AccessKey          string `json:"accessKey"`
+	SecretKey          string `json:"secretKey"`
+	GraceWindowSeconds int64  `json:"graceWindowSeconds,omitempty"`
+}
+
+// RotateRootCredentials - PUT /minio/admin/v3/rotate-root-credentials
+//
+// Rotates the server's root access/secret key. The previous credential
+// keeps working for a grace window (default 24h, tunable via
+// graceWindowSeconds in the request body) so that clients and scripts that
+// still hold the old credential are not cut off immediately. The change is
+// propagated to every peer in the cluster so inter-node RPC auth (which
+// also signs with the root credential) keeps working everywhere.
+//
+// Only the current root credential itself may call this - no IAM policy,
+// however permissive, is accepted, since granting it would amount to
+// granting root.
+func (a adminAPIHandlers) RotateRootCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI := newObjectLayerFn()
+	if objectAPI == nil || globalNotificationSys == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	cred, owner, s3Err := validateAdminSignature(ctx, r, "")
+	if s3Err != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	if !owner {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errIAMActionNotAllowed), r.URL)
+		return
+	}
+
+	if r.ContentLength > maxEConfigJSONSize || r.ContentLength == -1 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigTooLarge), r.URL)
+		return
+	}
+
+	reqBytes, err := madmin.DecryptData(cred.SecretKey, io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		adminLogIf(ctx, err)
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigBadJSON), r.URL)
+		return
+	}
+
+	var req rootCredentialRotateReq
+	if err = json.Unmarshal(reqBytes, &req); err != nil {
+		adminLogIf(ctx, err)
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigBadJSON), r.URL)
+		return
+	}
+
+	newCred, err := auth.CreateCredentials(req.AccessKey, req.SecretKey)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	graceWindow := defaultRootCredGraceWindow
+	if req.GraceWindowSeconds > 0 {
+		graceWindow = time.Duration(req.GraceWindowSeconds) * time.Second
+	}
+
+	if err := rotateRootCredentialCluster(ctx, newCred, graceWindow); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+}
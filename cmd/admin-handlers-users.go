@@ -79,7 +79,7 @@ func (a adminAPIHandlers) RemoveUser(w http.ResponseWriter, r *http.Request) {
 
 	// When the user is root credential you are not allowed to
 	// remove the root user. Also you cannot delete yourself.
-	if accessKey == globalActiveCred.AccessKey || accessKey == cred.AccessKey {
+	if accessKey == globalActiveCred().AccessKey || accessKey == cred.AccessKey {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errIAMActionNotAllowed), r.URL)
 		return
 	}
@@ -144,6 +144,59 @@ func (a adminAPIHandlers) ListUsers(w http.ResponseWriter, r *http.Request) {
 
 	password := cred.SecretKey
 
+	// Pagination/filtering is opt-in via query parameters, so that existing
+	// clients requesting the full map keep getting the same response shape
+	// as before. Only the internal (non-LDAP) user database is paginated.
+	marker := r.Form.Get("marker")
+	prefix := r.Form.Get("prefix")
+	status := madmin.AccountStatus(r.Form.Get("status"))
+	maxEntriesStr := r.Form.Get("maxEntries")
+	if marker != "" || prefix != "" || status != "" || maxEntriesStr != "" {
+		maxEntries := 0
+		if maxEntriesStr != "" {
+			var convErr error
+			maxEntries, convErr = strconv.Atoi(maxEntriesStr)
+			if convErr != nil || maxEntries < 0 {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+				return
+			}
+		}
+
+		users, nextMarker, err := globalIAMSys.ListUsersPaged(ctx, ListUsersOptions{
+			Marker:     marker,
+			MaxEntries: maxEntries,
+			Prefix:     prefix,
+			Status:     status,
+		})
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+
+		data, err := json.Marshal(struct {
+			Users       map[string]madmin.UserInfo `json:"users"`
+			NextMarker  string                     `json:"nextMarker,omitempty"`
+			IsTruncated bool                       `json:"isTruncated"`
+		}{
+			Users:       users,
+			NextMarker:  nextMarker,
+			IsTruncated: nextMarker != "",
+		})
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+
+		econfigData, err := madmin.EncryptData(password, data)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+
+		writeSuccessResponseJSON(w, econfigData)
+		return
+	}
+
 	allCredentials, err := globalIAMSys.ListUsers(ctx)
 	if err != nil {
 		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
@@ -267,7 +320,7 @@ func (a adminAPIHandlers) UpdateGroupMembers(w http.ResponseWriter, r *http.Requ
 		}
 		// When the user is root credential you are not allowed to
 		// add policies for root user.
-		if member == globalActiveCred.AccessKey {
+		if member == globalActiveCred().AccessKey {
 			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errIAMActionNotAllowed), r.URL)
 			return
 		}
@@ -309,6 +362,50 @@ func (a adminAPIHandlers) UpdateGroupMembers(w http.ResponseWriter, r *http.Requ
 	}))
 }
 
+// groupDescriptionReq is the body accepted by SetGroupDescription. It is
+// kept local to the server for now since madmin.GroupDesc does not yet carry
+// a description or tags; once the client-side type grows these fields this
+// can be dropped in favor of reusing it directly.
+type groupDescriptionReq struct {
+	Description string            `json:"description"`
+	Tags        map[string]string `json:"tags"`
+}
+
+// SetGroupDescription - PUT /minio/admin/v3/set-group-description?group=mygroup1
+//
+// Sets a free-form description and key-value tags on a group. The tags are
+// surfaced as "GroupTag/<key>" policy condition values (see
+// getConditionValues), so a statement can restrict access by group
+// attribute, e.g. Condition: {"StringEquals": {"GroupTag/department": "finance"}}.
+func (a adminAPIHandlers) SetGroupDescription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.AddUserToGroupAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	group := vars["group"]
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	var req groupDescriptionReq
+	if err = json.Unmarshal(data, &req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if _, err = globalIAMSys.SetGroupDescription(ctx, group, req.Description, req.Tags); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+}
+
 // GetGroup - /minio/admin/v3/group?group=mygroup1
 func (a adminAPIHandlers) GetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -360,6 +457,81 @@ func (a adminAPIHandlers) ListGroups(w http.ResponseWriter, r *http.Request) {
 	writeSuccessResponseJSON(w, body)
 }
 
+// ReloadIAM - POST /minio/admin/v3/reload-iam
+// Forces a reload of the in-memory IAM cache (users, groups, policies and
+// policy mappings) from backend storage, so operators can confirm that a
+// batch of out-of-band IAM changes has propagated without waiting on the
+// periodic refresh.
+func (a adminAPIHandlers) ReloadIAM(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ConfigUpdateAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if err := globalIAMSys.Load(ctx, false); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// iamCacheStatsResponse is the response body for IAMCacheStatsHandler.
+type iamCacheStatsResponse struct {
+	Users               int `json:"users"`
+	STSAccounts         int `json:"stsAccounts"`
+	Groups              int `json:"groups"`
+	Policies            int `json:"policies"`
+	UserPolicyMappings  int `json:"userPolicyMappings"`
+	GroupPolicyMappings int `json:"groupPolicyMappings"`
+
+	LastRefreshTimeUnixNano         uint64 `json:"lastRefreshTimeUnixNano"`
+	LastRefreshDurationMilliseconds uint64 `json:"lastRefreshDurationMilliseconds"`
+	TotalRefreshSuccesses           uint64 `json:"totalRefreshSuccesses"`
+	TotalRefreshFailures            uint64 `json:"totalRefreshFailures"`
+}
+
+// IAMCacheStatsHandler - GET /minio/admin/v3/iam-cache-stats
+// Reports counts of the various entries currently held in the in-memory
+// IAM cache, along with metrics about the last refresh cycle.
+func (a adminAPIHandlers) IAMCacheStatsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	stats, err := globalIAMSys.CacheStats()
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp := iamCacheStatsResponse{
+		Users:                           stats.Users,
+		STSAccounts:                     stats.STSAccounts,
+		Groups:                          stats.Groups,
+		Policies:                        stats.Policies,
+		UserPolicyMappings:              stats.UserPolicyMappings,
+		GroupPolicyMappings:             stats.GroupPolicyMappings,
+		LastRefreshTimeUnixNano:         globalIAMSys.LastRefreshTimeUnixNano,
+		LastRefreshDurationMilliseconds: globalIAMSys.LastRefreshDurationMilliseconds,
+		TotalRefreshSuccesses:           globalIAMSys.TotalRefreshSuccesses,
+		TotalRefreshFailures:            globalIAMSys.TotalRefreshFailures,
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, body)
+}
+
 // SetGroupStatus - PUT /minio/admin/v3/set-group-status?group=mygroup1&status=enabled
 func (a adminAPIHandlers) SetGroupStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -462,7 +634,7 @@ func (a adminAPIHandlers) AddUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Not allowed to add a user with same access key as root credential
-	if accessKey == globalActiveCred.AccessKey {
+	if accessKey == globalActiveCred().AccessKey {
 		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAddUserInvalidArgument), r.URL)
 		return
 	}
@@ -655,7 +827,7 @@ func (a adminAPIHandlers) AddServiceAccount(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	if createReq.AccessKey == globalActiveCred.AccessKey {
+	if createReq.AccessKey == globalActiveCred().AccessKey {
 		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAddUserInvalidArgument), r.URL)
 		return
 	}
@@ -680,7 +852,7 @@ func (a adminAPIHandlers) AddServiceAccount(w http.ResponseWriter, r *http.Reque
 		// For internal IDP, ensure that the targetUser's parent account exists.
 		// It could be a regular user account or the root account.
 		_, isRegularUser := globalIAMSys.GetUser(ctx, targetUser)
-		if !isRegularUser && targetUser != globalActiveCred.AccessKey {
+		if !isRegularUser && targetUser != globalActiveCred().AccessKey {
 			apiErr := toAdminAPIErr(ctx, errNoSuchUser)
 			apiErr.Description = fmt.Sprintf("Specified target user %s does not exist", targetUser)
 			writeErrorResponseJSON(ctx, w, apiErr, r.URL)
@@ -771,7 +943,7 @@ func (a adminAPIHandlers) AddServiceAccount(w http.ResponseWriter, r *http.Reque
 
 	// Call hook for cluster-replication if the service account is not for a
 	// root user.
-	if newCred.ParentUser != globalActiveCred.AccessKey {
+	if newCred.ParentUser != globalActiveCred().AccessKey {
 		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
 			Type: madmin.SRIAMItemSvcAcc,
 			SvcAccChange: &madmin.SRSvcAccChange{
@@ -897,7 +1069,7 @@ func (a adminAPIHandlers) UpdateServiceAccount(w http.ResponseWriter, r *http.Re
 	}
 
 	// Call site replication hook - non-root user accounts are replicated.
-	if svcAccount.ParentUser != globalActiveCred.AccessKey {
+	if svcAccount.ParentUser != globalActiveCred().AccessKey {
 		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
 			Type: madmin.SRIAMItemSvcAcc,
 			SvcAccChange: &madmin.SRSvcAccChange{
@@ -1164,7 +1336,7 @@ func (a adminAPIHandlers) DeleteServiceAccount(w http.ResponseWriter, r *http.Re
 	}
 
 	// Call site replication hook - non-root user accounts are replicated.
-	if svcAccount.ParentUser != "" && svcAccount.ParentUser != globalActiveCred.AccessKey {
+	if svcAccount.ParentUser != "" && svcAccount.ParentUser != globalActiveCred().AccessKey {
 		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
 			Type: madmin.SRIAMItemSvcAcc,
 			SvcAccChange: &madmin.SRSvcAccChange{
@@ -1256,7 +1428,7 @@ func (a adminAPIHandlers) ListAccessKeysBulk(w http.ResponseWriter, r *http.Requ
 		for user := range users {
 			checkedUserList = append(checkedUserList, user)
 		}
-		checkedUserList = append(checkedUserList, globalActiveCred.AccessKey)
+		checkedUserList = append(checkedUserList, globalActiveCred().AccessKey)
 	} else {
 		for _, user := range users {
 			// Validate the user
@@ -1411,6 +1583,22 @@ func (a adminAPIHandlers) AccountInfoHandler(w http.ResponseWriter, r *http.Requ
 			wr = true
 		}
 
+		if globalIAMSys.IsAllowed(policy.Args{
+			AccountName:     cred.AccessKey,
+			Groups:          cred.Groups,
+			Action:          policy.DeleteObjectAction,
+			BucketName:      bucketName,
+			ConditionValues: getConditionValues(r, "", cred),
+			IsOwner:         owner,
+			ObjectName:      "",
+			Claims:          cred.Claims,
+		}) {
+			// A policy that only grants DeleteObject (no PutObject) still
+			// lets the caller mutate bucket contents, so surface it as
+			// write access too rather than reporting read-only.
+			wr = true
+		}
+
 		return rd, wr
 	}
 
@@ -1454,7 +1642,7 @@ func (a adminAPIHandlers) AccountInfoHandler(w http.ResponseWriter, r *http.Requ
 
 	var buf []byte
 	switch {
-	case accountName == globalActiveCred.AccessKey || newGlobalAuthZPluginFn() != nil:
+	case accountName == globalActiveCred().AccessKey || newGlobalAuthZPluginFn() != nil:
 		// For owner account and when plugin authZ is configured always set
 		// effective policy as `consoleAdmin`.
 		//
@@ -1700,6 +1888,99 @@ func (a adminAPIHandlers) RemoveCannedPolicy(w http.ResponseWriter, r *http.Requ
 	}))
 }
 
+// policyParseAPIErr converts a policy.ParseConfig error into an APIError.
+// When the underlying error is a JSON syntax or type error, the byte offset
+// it carries is translated into a 1-indexed line/column within the submitted
+// document and appended to the description, so that API clients (and `mc`)
+// can point users at the exact spot of malformed JSON instead of just the
+// generic parse failure. Other errors, such as an unknown action, ARN or
+// condition key, are returned without a line/column.
+func policyParseAPIErr(err error, document []byte) APIError {
+	apiErr := APIError{
+		Code:           "XMinioMalformedIAMPolicy",
+		Description:    err.Error(),
+		HTTPStatusCode: http.StatusBadRequest,
+	}
+
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+		offset    int64
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return apiErr
+	}
+
+	line, col := lineAndColumnAtOffset(document, offset)
+	apiErr.Description = fmt.Sprintf("%s (line %d, column %d)", err.Error(), line, col)
+	return apiErr
+}
+
+// lineAndColumnAtOffset returns the 1-indexed line and column of the given
+// byte offset within data.
+func lineAndColumnAtOffset(data []byte, offset int64) (line, col int) {
+	line, col = 1, 1
+	for i := int64(0); i < offset && i < int64(len(data)); i++ {
+		if data[i] == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
+
+// lintPolicyStatements analyzes an already-validated policy for statements
+// that are syntactically correct but dangerously over-permissive, so that
+// warnings can be surfaced at creation time instead of being discovered
+// during a later security review. This is advisory only: none of these
+// findings block SetPolicy, since they can all be intentional (e.g. a
+// break-glass admin policy).
+func lintPolicyStatements(p policy.Policy) []string {
+	var warnings []string
+	for i, st := range p.Statements {
+		if st.Effect != policy.Allow {
+			continue
+		}
+
+		wildcardAction := false
+		for action := range st.Actions {
+			if strings.Contains(string(action), "*") {
+				wildcardAction = true
+				break
+			}
+		}
+
+		wildcardResource := len(st.Resources) == 0
+		for res := range st.Resources {
+			if strings.Contains(res.Pattern, "*") {
+				wildcardResource = true
+				break
+			}
+		}
+
+		if wildcardAction && wildcardResource {
+			warnings = append(warnings, fmt.Sprintf(
+				"statement #%d allows a wildcard action on a wildcard resource with Allow effect", i))
+		} else if (wildcardAction || wildcardResource) && len(st.Conditions) == 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"statement #%d uses a wildcard action or resource with no Condition constraints", i))
+		}
+
+		if len(st.NotActions) > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"statement #%d uses NotAction, which grants every action except the ones listed and widens automatically as new actions are added", i))
+		}
+	}
+	return warnings
+}
+
 // AddCannedPolicy - PUT /minio/admin/v3/add-canned-policy?name=<policy_name>
 func (a adminAPIHandlers) AddCannedPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1745,7 +2026,7 @@ func (a adminAPIHandlers) AddCannedPolicy(w http.ResponseWriter, r *http.Request
 
 	iamPolicy, err := policy.ParseConfig(bytes.NewReader(iamPolicyBytes))
 	if err != nil {
-		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		writeErrorResponseJSON(ctx, w, policyParseAPIErr(err, iamPolicyBytes), r.URL)
 		return
 	}
 
@@ -1761,6 +2042,13 @@ func (a adminAPIHandlers) AddCannedPolicy(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// The policy is valid and has been saved; any over-permissive statements
+	// are now just logged as warnings for the admin to review, since
+	// AddCannedPolicy's response body is discarded by clients on success.
+	if warnings := lintPolicyStatements(*iamPolicy); len(warnings) > 0 {
+		adminLogIf(ctx, fmt.Errorf("policy %q has over-permissive statements: %s", policyName, strings.Join(warnings, "; ")), logger.WarningKind)
+	}
+
 	// Call cluster-replication policy creation hook to replicate policy to
 	// other minio clusters.
 	replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
@@ -1803,7 +2091,7 @@ func (a adminAPIHandlers) SetPolicyForUserOrGroup(w http.ResponseWriter, r *http
 		}
 		// When the user is root credential you are not allowed to
 		// add policies for root user.
-		if entityName == globalActiveCred.AccessKey {
+		if entityName == globalActiveCred().AccessKey {
 			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errIAMActionNotAllowed), r.URL)
 			return
 		}
@@ -2341,7 +2629,7 @@ func (a adminAPIHandlers) importIAM(w http.ResponseWriter, r *http.Request, apiV
 			}
 			for accessKey, ureq := range userAccts {
 				// Not allowed to add a user with same access key as root credential
-				if accessKey == globalActiveCred.AccessKey {
+				if accessKey == globalActiveCred().AccessKey {
 					writeErrorResponseJSON(ctx, w, importErrorWithAPIErr(ctx, ErrAddUserInvalidArgument, err, allUsersFile, accessKey), r.URL)
 					return
 				}
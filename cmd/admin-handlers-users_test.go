@@ -980,7 +980,7 @@ func (s *TestSuiteIAM) TestServiceAccountOpsByUser(c *check) {
 	c.assertSvcAccDeletion(ctx, s, userAdmClient, accessKey, bucket)
 
 	// 6. Check that service account cannot be created for some other user.
-	c.mustNotCreateSvcAccount(ctx, globalActiveCred.AccessKey, userAdmClient)
+	c.mustNotCreateSvcAccount(ctx, globalActiveCred().AccessKey, userAdmClient)
 }
 
 func (s *TestSuiteIAM) TestServiceAccountDurationSecondsCondition(c *check) {
@@ -1208,7 +1208,7 @@ func (s *TestSuiteIAM) TestServiceAccountPrivilegeEscalationBug(c *check) {
 
 	// Create a service account for the root user.
 	cr, err := s.adm.AddServiceAccount(ctx, madmin.AddServiceAccountReq{
-		TargetUser: globalActiveCred.AccessKey,
+		TargetUser: globalActiveCred().AccessKey,
 		Policy:     pubPolicyBytes,
 	})
 	if err != nil {
@@ -1406,7 +1406,7 @@ func (s *TestSuiteIAM) TestAccMgmtPlugin(c *check) {
 
 	// 6. Check that service account **can** be created for some other user.
 	// This is possible because the policy enforced in the plugin.
-	c.mustCreateSvcAccount(ctx, globalActiveCred.AccessKey, userAdmClient)
+	c.mustCreateSvcAccount(ctx, globalActiveCred().AccessKey, userAdmClient)
 }
 
 func (c *check) mustCreateIAMUser(ctx context.Context, admClnt *madmin.AdminClient) madmin.Credentials {
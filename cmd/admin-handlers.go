@@ -839,6 +839,64 @@ func (a adminAPIHandlers) MetricsHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// BandwidthMonitorHandler - GET /minio/admin/v3/bandwidth?buckets={buckets}&interval={interval}&n={n}
+// ----------
+// Streams the current/limit bandwidth for the given buckets (all buckets if
+// none are specified), across the whole cluster, once per interval, for
+// chargeback reporting and to verify replication/quota throttling behavior.
+func (a adminAPIHandlers) BandwidthMonitorHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.BandwidthMonitorAction)
+	if objectAPI == nil {
+		return
+	}
+
+	const defaultBandwidthInterval = time.Second
+
+	interval, err := time.ParseDuration(r.Form.Get("interval"))
+	if err != nil || interval < time.Second {
+		interval = defaultBandwidthInterval
+	}
+
+	n, err := strconv.Atoi(r.Form.Get("n"))
+	if err != nil || n <= 0 {
+		n = math.MaxInt32
+	}
+
+	var buckets []string
+	if b := r.Form.Get("buckets"); b != "" {
+		buckets = strings.Split(b, ",")
+	}
+
+	done := ctx.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	w.Header().Set(xhttp.ContentType, string(mimeJSON))
+
+	enc := json.NewEncoder(w)
+	for n > 0 {
+		report := globalNotificationSys.GetBandwidthReports(ctx, buckets...)
+		if err := enc.Encode(&report); err != nil {
+			n = 0
+		}
+
+		n--
+		if n <= 0 {
+			break
+		}
+
+		// Flush before waiting for next...
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+	}
+}
+
 // DataUsageInfoHandler - GET /minio/admin/v3/datausage?capacity={true}
 // ----------
 // Get server/cluster data usage info
@@ -1103,6 +1161,13 @@ func (a adminAPIHandlers) StartProfilingHandler(w http.ResponseWriter, r *http.R
 	writeSuccessResponseJSON(w, startProfilingResultInBytes)
 }
 
+// maxProfilerDuration caps how long a single profiling session started via
+// ProfileHandler may run for, to avoid a caller pinning CPU/heap profiling
+// on every node indefinitely (and growing the in-memory profile buffers
+// without bound) by passing a very large duration. A missing duration
+// defaults to one minute.
+const maxProfilerDuration = 1 * time.Hour
+
 // ProfileHandler - POST /minio/admin/v3/profile/?profilerType={profilerType}
 // ----------
 // Enable server profiling
@@ -1131,6 +1196,10 @@ func (a adminAPIHandlers) ProfileHandler(w http.ResponseWriter, r *http.Request)
 			return
 		}
 	}
+	if duration <= 0 || duration > maxProfilerDuration {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
 
 	globalProfilerMu.Lock()
 	if globalProfiler == nil {
@@ -1670,7 +1739,7 @@ func (a adminAPIHandlers) NetperfHandler(w http.ResponseWriter, r *http.Request)
 }
 
 func isAllowedRWAccess(r *http.Request, cred auth.Credentials, bucketName string) (rd, wr bool) {
-	owner := cred.AccessKey == globalActiveCred.AccessKey
+	owner := cred.AccessKey == globalActiveCred().AccessKey
 
 	// Set prefix value for "s3:prefix" policy conditionals.
 	r.Header.Set("prefix", "")
@@ -3066,6 +3135,110 @@ func fetchLambdaInfo() []map[string][]madmin.TargetIDStatus {
 	return notify
 }
 
+// NotificationTargetHealth is the health snapshot of a single configured
+// notification target.
+type NotificationTargetHealth struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Online bool   `json:"online"`
+
+	TotalEvents  int64 `json:"totalEvents"`
+	FailedEvents int64 `json:"failedEvents"`
+	QueuedEvents int   `json:"queuedEvents"`
+
+	LastSuccessTime time.Time `json:"lastSuccessTime,omitempty"`
+
+	RecentErrors []NotificationTargetError `json:"recentErrors,omitempty"`
+}
+
+// NotificationTargetError is a single timestamped delivery error sample.
+type NotificationTargetError struct {
+	Time time.Time `json:"time"`
+	Err  string    `json:"error"`
+}
+
+// NotificationTargetHealthHandler - GET /minio/admin/v3/notification/health
+// ----------
+// Reports, per configured notification target, connection state, last
+// successful delivery time, queued backlog size and recent delivery error
+// samples - so broken event pipelines can be spotted without scraping logs.
+func (a adminAPIHandlers) NotificationTargetHealthHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Validate request signature.
+	_, adminAPIErr := checkAdminRequestAuth(ctx, r, policy.ServerInfoAdminAction, "")
+	if adminAPIErr != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(adminAPIErr), r.URL)
+		return
+	}
+
+	stats := globalEventNotifier.Stats()
+
+	health := make([]NotificationTargetHealth, 0, len(stats.TargetStats))
+	for _, tgt := range globalEventNotifier.Targets() {
+		targetID := tgt.ID()
+		online, _ := tgt.IsActive()
+
+		tstat := stats.TargetStats[targetID]
+		recentErrors := make([]NotificationTargetError, 0, len(tstat.RecentErrors))
+		for _, e := range tstat.RecentErrors {
+			recentErrors = append(recentErrors, NotificationTargetError{Time: e.Time, Err: e.Err})
+		}
+
+		health = append(health, NotificationTargetHealth{
+			ID:              targetID.ID,
+			Name:            targetID.Name,
+			Online:          online,
+			TotalEvents:     tstat.TotalEvents,
+			FailedEvents:    tstat.FailedEvents,
+			QueuedEvents:    tstat.CurrentQueue,
+			LastSuccessTime: tstat.LastSuccessTime,
+			RecentErrors:    recentErrors,
+		})
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].ID < health[j].ID
+	})
+
+	jsonBytes, err := json.Marshal(health)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
+// NetworkThroughputHandler - GET /minio/admin/v3/network/throughput
+// ----------
+// Get cumulative network byte counters (S3 and internode) for every node
+// in the cluster, to inform autoscaling and connection draining decisions.
+func (a adminAPIHandlers) NetworkThroughputHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Validate request signature.
+	_, adminAPIErr := checkAdminRequestAuth(ctx, r, policy.ServerInfoAdminAction, "")
+	if adminAPIErr != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(adminAPIErr), r.URL)
+		return
+	}
+
+	info := globalNotificationSys.NetworkThroughput(ctx)
+
+	sort.Slice(info, func(i, j int) bool {
+		return info[i].Addr < info[j].Addr
+	})
+
+	jsonBytes, err := json.Marshal(info)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 // fetchKMSStatus fetches KMS-related status information for all instances
 func fetchKMSStatus(ctx context.Context) []madmin.KMS {
 	if GlobalKMS == nil {
@@ -211,7 +211,7 @@ func testServicesCmdHandler(cmd cmdType, t *testing.T) {
 			testServiceSignalReceiver(cmd, t)
 		}()
 	}
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 
 	req, err := getServiceCmdRequest(cmd, credentials)
 	if err != nil {
@@ -253,7 +253,7 @@ func buildAdminRequest(queryVal url.Values, method, path string,
 		return nil, err
 	}
 
-	cred := globalActiveCred
+	cred := globalActiveCred()
 	err = signRequestV4(req, cred.AccessKey, cred.SecretKey)
 	if err != nil {
 		return nil, err
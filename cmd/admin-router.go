@@ -160,14 +160,26 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Info operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/info").HandlerFunc(adminMiddleware(adminAPI.ServerInfoHandler, traceAllFlag, noObjLayerFlag))
 		adminRouter.Methods(http.MethodGet, http.MethodPost).Path(adminVersion + "/inspect-data").HandlerFunc(adminMiddleware(adminAPI.InspectDataHandler, noGZFlag, traceHdrsS3HFlag))
+		// Browser branding info
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/branding-info").HandlerFunc(adminMiddleware(adminAPI.BrandingInfoHandler, traceAllFlag, noObjLayerFlag))
+		// Anonymized usage telemetry info
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/telemetry-info").HandlerFunc(adminMiddleware(adminAPI.TelemetryInfoHandler, traceAllFlag, noObjLayerFlag))
 
 		// StorageInfo operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/storageinfo").HandlerFunc(adminMiddleware(adminAPI.StorageInfoHandler, traceAllFlag))
 		// DataUsageInfo operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/datausageinfo").HandlerFunc(adminMiddleware(adminAPI.DataUsageInfoHandler, traceAllFlag))
+
+		// Notification target health
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/notification/health").HandlerFunc(adminMiddleware(adminAPI.NotificationTargetHealthHandler, traceAllFlag))
+		// Per-node network throughput
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/network/throughput").HandlerFunc(adminMiddleware(adminAPI.NetworkThroughputHandler, traceAllFlag))
 		// Metrics operation
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics").HandlerFunc(adminMiddleware(adminAPI.MetricsHandler, traceHdrsS3HFlag))
 
+		// Bandwidth monitor
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/bandwidth").HandlerFunc(adminMiddleware(adminAPI.BandwidthMonitorHandler, traceHdrsS3HFlag))
+
 		if globalIsDistErasure || globalIsErasure {
 			// Heal operations
 
@@ -188,6 +200,14 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/start").HandlerFunc(adminMiddleware(adminAPI.RebalanceStart, traceAllFlag))
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/rebalance/status").HandlerFunc(adminMiddleware(adminAPI.RebalanceStatus, traceAllFlag))
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/stop").HandlerFunc(adminMiddleware(adminAPI.RebalanceStop, traceAllFlag))
+
+			// Ignored drives operations
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/ignored-disks").HandlerFunc(adminMiddleware(adminAPI.ListIgnoredDisksHandler, traceAllFlag))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/ignored-disks/clear").HandlerFunc(adminMiddleware(adminAPI.ClearIgnoredDiskHandler, traceAllFlag)).Queries("endpoint", "{endpoint:.*}")
+
+			// Multipart upload cleanup operations
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-multipart-uploads").HandlerFunc(adminMiddleware(adminAPI.ListMultipartUploadsHandler, traceAllFlag))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/abort-multipart-uploads").HandlerFunc(adminMiddleware(adminAPI.AbortMultipartUploadsHandler, traceAllFlag))
 		}
 
 		// Profiling operations - deprecated API
@@ -230,6 +250,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Add user IAM
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/accountinfo").HandlerFunc(adminMiddleware(adminAPI.AccountInfoHandler, traceAllFlag))
 
+		// Rotate root credentials
+		adminRouter.Methods(http.MethodPut).Path(adminVersion + "/rotate-root-credentials").HandlerFunc(adminMiddleware(adminAPI.RotateRootCredentials))
+
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/add-user").HandlerFunc(adminMiddleware(adminAPI.AddUser)).Queries("accessKey", "{accessKey:.*}")
 
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-user-status").HandlerFunc(adminMiddleware(adminAPI.SetUserStatus)).Queries("accessKey", "{accessKey:.*}").Queries("status", "{status:.*}")
@@ -288,6 +311,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Set Group Status
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-group-status").HandlerFunc(adminMiddleware(adminAPI.SetGroupStatus)).Queries("group", "{group:.*}").Queries("status", "{status:.*}")
 
+		// Set Group Description and Tags
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-group-description").HandlerFunc(adminMiddleware(adminAPI.SetGroupDescription)).Queries("group", "{group:.*}")
+
 		// Export IAM info to zipped file
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/export-iam").HandlerFunc(adminMiddleware(adminAPI.ExportIAM, noGZFlag))
 
@@ -312,6 +338,13 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// LDAP IAM operations
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/idp/ldap/policy-entities").HandlerFunc(adminMiddleware(adminAPI.ListLDAPPolicyMappingEntities))
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/idp/ldap/policy/{operation}").HandlerFunc(adminMiddleware(adminAPI.AttachDetachPolicyLDAP))
+
+		// Force a reload of the in-memory IAM cache from backend storage
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/reload-iam").HandlerFunc(adminMiddleware(adminAPI.ReloadIAM))
+
+		// Report counts of entries held in the in-memory IAM cache, along
+		// with the last refresh cycle's timing
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/iam-cache-stats").HandlerFunc(adminMiddleware(adminAPI.IAMCacheStatsHandler))
 		// -- END IAM APIs --
 
 		// GetBucketQuotaConfig
@@ -321,6 +354,40 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 			adminMiddleware(adminAPI.PutBucketQuotaConfigHandler)).Queries("bucket", "{bucket:.*}")
 
+		// GetBucketAccess
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-access").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketAccessHandler)).Queries("bucket", "{bucket:.*}")
+		// SetBucketAccess
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/set-bucket-access").HandlerFunc(
+			adminMiddleware(adminAPI.SetBucketAccessHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketInventoryConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-inventory").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketInventoryConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// PutBucketInventoryConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-inventory").HandlerFunc(
+			adminMiddleware(adminAPI.PutBucketInventoryConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// DeleteBucketInventoryConfig
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/del-bucket-inventory").HandlerFunc(
+			adminMiddleware(adminAPI.DeleteBucketInventoryConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketCompressionDictConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-compression-dict").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketCompressionDictHandler)).Queries("bucket", "{bucket:.*}")
+		// PutBucketCompressionDictConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-compression-dict").HandlerFunc(
+			adminMiddleware(adminAPI.PutBucketCompressionDictHandler)).Queries("bucket", "{bucket:.*}")
+
+		// GetBucketWebsiteConfig
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-website").HandlerFunc(
+			adminMiddleware(adminAPI.GetBucketWebsiteConfigHandler)).Queries("bucket", "{bucket:.*}")
+		// PutBucketWebsiteConfig
+		adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-website").HandlerFunc(
+			adminMiddleware(adminAPI.PutBucketWebsiteHandler)).Queries("bucket", "{bucket:.*}")
+		// DeleteBucketWebsiteConfig
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/del-bucket-website").HandlerFunc(
+			adminMiddleware(adminAPI.DeleteBucketWebsiteConfigHandler)).Queries("bucket", "{bucket:.*}")
+
 		// Bucket replication operations
 		// GetBucketTargetHandler
 		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/list-remote-targets").HandlerFunc(
@@ -412,6 +479,10 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		// Console Logs
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/log").HandlerFunc(adminMiddleware(adminAPI.ConsoleLogHandler, traceAllFlag))
 
+		// Runtime log level
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/log-level").HandlerFunc(adminMiddleware(adminAPI.GetLogLevelHandler))
+		adminRouter.Methods(http.MethodPut).Path(adminVersion + "/log-level").HandlerFunc(adminMiddleware(adminAPI.SetLogLevelHandler))
+
 		// -- KMS APIs --
 		//
 		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/kms/status").HandlerFunc(adminMiddleware(adminAPI.KMSStatusHandler, traceAllFlag))
@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"fmt"
 	"math"
 	"net/http"
 	"os"
@@ -71,6 +72,13 @@ func getLocalServerProperty(endpointServerPools EndpointServerPools, r *http.Req
 					}
 				}
 			}
+			// Append a drift warning if this peer's clock has drifted far
+			// enough to threaten signature validation or lock lease
+			// expiry; leave the common, no-drift case untouched so
+			// existing consumers of this field see no change.
+			if drift, ok := clockDrift(nodeName); ok && absDuration(drift) > DefaultSkewTime {
+				network[nodeName] += fmt.Sprintf(" (clock drift %s exceeds tolerated skew %s)", drift, DefaultSkewTime)
+			}
 		}
 	}
 
@@ -448,6 +448,8 @@ const (
 
 	ErrIAMNotInitialized
 
+	ErrAdminNoSuchDisk
+
 	apiErrCodeEnd // This is used only for the testing code
 )
 
@@ -1318,6 +1320,11 @@ var errorCodes = errorCodeMap{
 		Description:    "IAM sub-system not initialized yet, please try again.",
 		HTTPStatusCode: http.StatusServiceUnavailable,
 	},
+	ErrAdminNoSuchDisk: {
+		Code:           "XMinioAdminNoSuchDisk",
+		Description:    "The specified drive is not in the ignored drives list.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
 	ErrBucketMetadataNotInitialized: {
 		Code:           "XMinioBucketMetadataNotInitialized",
 		Description:    "Bucket metadata not initialized yet, please try again.",
@@ -2424,6 +2431,12 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 	default:
 		if strings.Contains(err.Error(), "request declared a Content-Length") {
 			apiErr = ErrIncompleteBody
+		} else if strings.Contains(err.Error(), "http: request body too large") {
+			// Raised by the http.MaxBytesReader wrapping r.Body in
+			// setRequestLimitMiddleware once a streamed/chunked body (with
+			// no usable Content-Length to reject early) exceeds
+			// requestMaxBodySize mid-read.
+			apiErr = ErrEntityTooLarge
 		} else {
 			apiErr = ErrInternalError
 		}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
 	xhttp "github.com/minio/minio/internal/http"
 	xxml "github.com/minio/xxml"
 )
@@ -216,6 +217,25 @@ func setObjectHeaders(ctx context.Context, w http.ResponseWriter, objInfo Object
 		w.Header()[xhttp.AmzStorageClass] = []string{filterStorageClass(ctx, objInfo.TransitionedObject.Tier)}
 	}
 
+	// Report the erasure data/parity block counts the object is currently
+	// stored with, so clients and auditors can confirm its redundancy.
+	if objInfo.DataBlocks > 0 {
+		w.Header()[xhttp.MinIOErasureDataParity] = []string{fmt.Sprintf("%d,%d", objInfo.DataBlocks, objInfo.ParityBlocks)}
+	}
+
+	// Return any full-object x-amz-checksum-* recorded at upload time, same
+	// as GetObjectAttributes does. Skipped for encrypted objects since that
+	// needs the request's decryption headers, which aren't available here.
+	if len(objInfo.Checksum) > 0 {
+		if _, encrypted := crypto.IsEncrypted(objInfo.UserDefined); !encrypted {
+			for alg, value := range hash.ReadCheckSums(objInfo.Checksum, 0) {
+				if key := hash.NewChecksumType(alg).Key(); key != "" {
+					w.Header()[key] = []string{strings.Split(value, "-")[0]}
+				}
+			}
+		}
+	}
+
 	if lc, err := globalLifecycleSys.Get(objInfo.Bucket); err == nil {
 		lc.SetPredictionHeaders(w, objInfo.ToLifecycleOpts())
 	}
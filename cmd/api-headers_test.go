@@ -18,9 +18,48 @@
 package cmd
 
 import (
+	"context"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
 )
 
+func TestSetObjectHeadersErasureDataParity(t *testing.T) {
+	w := httptest.NewRecorder()
+	objInfo := ObjectInfo{
+		Bucket:       "testbucket",
+		Name:         "testobject",
+		Size:         10,
+		DataBlocks:   4,
+		ParityBlocks: 2,
+	}
+	if err := setObjectHeaders(context.Background(), w, objInfo, nil, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Header()[xhttp.MinIOErasureDataParity]; len(got) != 1 || got[0] != "4,2" {
+		t.Fatalf("expected erasure data/parity header %q, got %q", "4,2", got)
+	}
+}
+
+func TestSetObjectHeadersChecksum(t *testing.T) {
+	crc32sum := hash.NewChecksumFromData(hash.ChecksumCRC32, []byte("abcd"))
+	w := httptest.NewRecorder()
+	objInfo := ObjectInfo{
+		Bucket:   "testbucket",
+		Name:     "testobject",
+		Size:     4,
+		Checksum: crc32sum.AppendTo(nil, nil),
+	}
+	if err := setObjectHeaders(context.Background(), w, objInfo, nil, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Header()[xhttp.AmzChecksumCRC32]; len(got) != 1 || got[0] != crc32sum.Encoded {
+		t.Fatalf("expected checksum header %q, got %q", crc32sum.Encoded, got)
+	}
+}
+
 func TestNewRequestID(t *testing.T) {
 	// Ensure that it returns an alphanumeric result of length 16.
 	id := mustGetRequestID(UTCNow())
@@ -387,6 +387,11 @@ func registerAPIRouter(router *mux.Router) {
 			HeadersRegexp(xhttp.AmzSnowballExtract, "true").
 			HandlerFunc(s3APIMiddleware(api.PutObjectExtractHandler, traceHdrsS3HFlag))
 
+		// AppendObject (MinIO extension)
+		router.Methods(http.MethodPut).Path("/{object:.+}").
+			HeadersRegexp(xhttp.MinIOAppendObject, "true").
+			HandlerFunc(s3APIMiddleware(api.AppendObjectHandler, traceHdrsS3HFlag))
+
 		// PutObject
 		router.Methods(http.MethodPut).Path("/{object:.+}").
 			HandlerFunc(s3APIMiddleware(api.PutObjectHandler, traceHdrsS3HFlag))
@@ -556,6 +561,10 @@ func registerAPIRouter(router *mux.Router) {
 		router.Methods(http.MethodPut).
 			HandlerFunc(s3APIMiddleware(api.ResetBucketReplicationStartHandler)).
 			Queries("replication-reset", "")
+		// ReplayBucketEvents - MinIO extension API
+		router.Methods(http.MethodPut).
+			HandlerFunc(s3APIMiddleware(api.ReplayBucketEventsHandler)).
+			Queries("replay-events", "")
 
 		// PutBucket
 		router.Methods(http.MethodPut).
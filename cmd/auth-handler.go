@@ -233,10 +233,10 @@ func getClaimsFromTokenWithSecret(token, secret string) (*xjwt.MapClaims, error)
 	// on the client side and is treated like an opaque value.
 	claims, err := auth.ExtractClaims(token, secret)
 	if err != nil {
-		if subtle.ConstantTimeCompare([]byte(secret), []byte(globalActiveCred.SecretKey)) == 1 {
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(globalActiveCred().SecretKey)) == 1 {
 			return nil, errAuthentication
 		}
-		claims, err = auth.ExtractClaims(token, globalActiveCred.SecretKey)
+		claims, err = auth.ExtractClaims(token, globalActiveCred().SecretKey)
 		if err != nil {
 			return nil, errAuthentication
 		}
@@ -268,7 +268,7 @@ func getClaimsFromTokenWithSecret(token, secret string) (*xjwt.MapClaims, error)
 
 // Fetch claims in the security token returned by the client.
 func getClaimsFromToken(token string) (map[string]interface{}, error) {
-	jwtClaims, err := getClaimsFromTokenWithSecret(token, globalActiveCred.SecretKey)
+	jwtClaims, err := getClaimsFromTokenWithSecret(token, globalActiveCred().SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -302,14 +302,14 @@ func checkClaimsFromToken(r *http.Request, cred auth.Credentials) (map[string]in
 	if cred.IsTemp() && cred.IsExpired() {
 		return nil, toAPIErrorCode(r.Context(), errInvalidAccessKeyID)
 	}
-	secret := globalActiveCred.SecretKey
+	secret := globalActiveCred().SecretKey
 	if globalSiteReplicationSys.isEnabled() && cred.AccessKey != siteReplicatorSvcAcc {
 		nsecret, err := getTokenSigningKey()
 		if err != nil {
 			return nil, toAPIErrorCode(r.Context(), err)
 		}
 		// sign root's temporary accounts also with site replicator creds
-		if cred.ParentUser != globalActiveCred.AccessKey || cred.IsTemp() {
+		if cred.ParentUser != globalActiveCred().AccessKey || cred.IsTemp() {
 			secret = nsecret
 		}
 	}
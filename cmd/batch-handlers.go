@@ -71,6 +71,7 @@ type BatchJobRequest struct {
 	Replicate *BatchJobReplicateV1 `yaml:"replicate" json:"replicate"`
 	KeyRotate *BatchJobKeyRotateV1 `yaml:"keyrotate" json:"keyrotate"`
 	Expire    *BatchJobExpire      `yaml:"expire" json:"expire"`
+	TagUpdate *BatchJobTagUpdateV1 `yaml:"tagupdate" json:"tagupdate"`
 	ctx       context.Context      `msg:"-"`
 }
 
@@ -763,6 +764,8 @@ func (ri *batchJobInfo) getJobReportPath() (string, error) {
 		fileName = batchKeyRotationName
 	case madmin.BatchJobExpire:
 		fileName = batchExpireName
+	case batchJobTagUpdate:
+		fileName = batchTagUpdateName
 	default:
 		return "", fmt.Errorf("unknown job type: %v", ri.JobType)
 	}
@@ -779,6 +782,8 @@ func (ri *batchJobInfo) loadOrInit(ctx context.Context, api ObjectLayer, job Bat
 			ri.Version = batchKeyRotateVersionV1
 		case job.Expire != nil:
 			ri.Version = batchExpireVersionV1
+		case job.TagUpdate != nil:
+			ri.Version = batchTagUpdateVersionV1
 		}
 		return nil
 	}
@@ -806,6 +811,9 @@ func (ri *batchJobInfo) loadByPath(ctx context.Context, api ObjectLayer, path st
 	case batchExpireName:
 		version = batchExpireVersionV1
 		format = batchExpireFormat
+	case batchTagUpdateName:
+		version = batchTagUpdateVersionV1
+		format = batchTagUpdateFormat
 	default:
 		return errors.New("no supported batch job request specified")
 	}
@@ -938,6 +946,11 @@ func (ri *batchJobInfo) updateAfter(ctx context.Context, api ObjectLayer, durati
 			version = batchExpireVersion
 			jobTyp = string(job.Type())
 			ri.Version = batchExpireVersionV1
+		case batchJobTagUpdate:
+			format = batchTagUpdateFormat
+			version = batchTagUpdateVersion
+			jobTyp = string(job.Type())
+			ri.Version = batchTagUpdateVersionV1
 		default:
 			return errInvalidArgument
 		}
@@ -1480,6 +1493,8 @@ func (j BatchJobRequest) Type() madmin.BatchJobType {
 		return madmin.BatchJobKeyRotate
 	case j.Expire != nil:
 		return madmin.BatchJobExpire
+	case j.TagUpdate != nil:
+		return batchJobTagUpdate
 	}
 	return madmin.BatchJobType("unknown")
 }
@@ -1494,6 +1509,8 @@ func (j BatchJobRequest) Validate(ctx context.Context, o ObjectLayer) error {
 		return j.KeyRotate.Validate(ctx, j, o)
 	case j.Expire != nil:
 		return j.Expire.Validate(ctx, j, o)
+	case j.TagUpdate != nil:
+		return j.TagUpdate.Validate(ctx, j, o)
 	}
 	return errInvalidArgument
 }
@@ -1511,6 +1528,8 @@ func (j BatchJobRequest) getJobReportPath() (string, error) {
 		fileName = batchKeyRotationName
 	case j.Expire != nil:
 		fileName = batchExpireName
+	case j.TagUpdate != nil:
+		fileName = batchTagUpdateName
 	default:
 		return "", errors.New("unknown job type")
 	}
@@ -1643,6 +1662,8 @@ func (a adminAPIHandlers) BatchJobStatus(w http.ResponseWriter, r *http.Request)
 			req.KeyRotate = &BatchJobKeyRotateV1{}
 		case madmin.BatchJobExpire:
 			req.Expire = &BatchJobExpire{}
+		case batchJobTagUpdate:
+			req.TagUpdate = &BatchJobTagUpdateV1{}
 		default:
 			writeErrorResponseJSON(ctx, w, toAPIError(ctx, errors.New("job ID format unrecognized")), r.URL)
 			return
@@ -1985,6 +2006,13 @@ func (j *BatchJobPool) AddWorker() {
 						continue
 					}
 				}
+			case job.TagUpdate != nil:
+				if err := job.TagUpdate.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						batchLogIf(j.ctx, err)
+						continue
+					}
+				}
 			}
 			j.canceler(job.ID, false)
 		case <-j.workerKillCh:
@@ -2066,6 +2094,7 @@ const (
 	batchJobMetricReplication batchJobMetric = iota
 	batchJobMetricKeyRotation
 	batchJobMetricExpire
+	batchJobMetricTagUpdate
 )
 
 func batchJobTrace(d batchJobMetric, job string, startTime time.Time, duration time.Duration, info objTraceInfoer, attempts int, err error) madmin.TraceInfo {
@@ -2270,6 +2299,10 @@ func (m *batchJobMetrics) trace(d batchJobMetric, job string, attempts int) func
 			if globalTrace.NumSubscribers(madmin.TraceBatchExpire) > 0 {
 				globalTrace.Publish(batchJobTrace(d, job, startTime, duration, info, attempts, err))
 			}
+		case batchJobMetricTagUpdate:
+			if globalTrace.NumSubscribers(madmin.TraceBatchReplication) > 0 {
+				globalTrace.Publish(batchJobTrace(d, job, startTime, duration, info, attempts, err))
+			}
 		}
 	}
 }
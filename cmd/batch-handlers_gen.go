@@ -179,6 +179,24 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "TagUpdate":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "TagUpdate")
+					return
+				}
+				z.TagUpdate = nil
+			} else {
+				if z.TagUpdate == nil {
+					z.TagUpdate = new(BatchJobTagUpdateV1)
+				}
+				err = z.TagUpdate.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "TagUpdate")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -192,9 +210,9 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 7
 	// write "ID"
-	err = en.Append(0x86, 0xa2, 0x49, 0x44)
+	err = en.Append(0x87, 0xa2, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -274,15 +292,32 @@ func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "TagUpdate"
+	err = en.Append(0xa9, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	if z.TagUpdate == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.TagUpdate.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "TagUpdate")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 7
 	// string "ID"
-	o = append(o, 0x86, 0xa2, 0x49, 0x44)
+	o = append(o, 0x87, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "User"
 	o = append(o, 0xa4, 0x55, 0x73, 0x65, 0x72)
@@ -323,6 +358,17 @@ func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 			return
 		}
 	}
+	// string "TagUpdate"
+	o = append(o, 0xa9, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65)
+	if z.TagUpdate == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.TagUpdate.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "TagUpdate")
+			return
+		}
+	}
 	return
 }
 
@@ -413,6 +459,23 @@ func (z *BatchJobRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "TagUpdate":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.TagUpdate = nil
+			} else {
+				if z.TagUpdate == nil {
+					z.TagUpdate = new(BatchJobTagUpdateV1)
+				}
+				bts, err = z.TagUpdate.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TagUpdate")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -445,6 +508,12 @@ func (z *BatchJobRequest) Msgsize() (s int) {
 	} else {
 		s += z.Expire.Msgsize()
 	}
+	s += 10
+	if z.TagUpdate == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.TagUpdate.Msgsize()
+	}
 	return
 }
 
@@ -0,0 +1,264 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/pkg/v3/env"
+	"github.com/minio/pkg/v3/workers"
+)
+
+// batchJobTagUpdate identifies a batch tag-update job; kept local since it is
+// a MinIO specific extension not present in madmin.SupportedJobTypes.
+const batchJobTagUpdate madmin.BatchJobType = "tagupdate"
+
+// tagupdate:
+//   apiVersion: v1
+//   bucket: BUCKET
+//   prefix: PREFIX
+//   tags:
+//     - key: "name"
+//       value: "value"
+// # optional flags based filtering criteria
+// # for all objects
+// flags:
+//   filter:
+//     newerThan: "7d" # match objects newer than this value (e.g. 7d10h31s)
+//     olderThan: "7d" # match objects older than this value (e.g. 7d10h31s)
+//     createdAfter: "date" # match objects created after "date"
+//     createdBefore: "date" # match objects created before "date"
+//   notify:
+//     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+//     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+//   retry:
+//     attempts: 10 # number of retries for the job before giving up
+//     delay: "500ms" # least amount of delay between each retry
+
+//go:generate msgp -file $GOFILE -unexported
+
+// BatchJobTagUpdateFilter holds the filters currently supported for batch tag updates
+type BatchJobTagUpdateFilter struct {
+	NewerThan     time.Duration `yaml:"newerThan,omitempty" json:"newerThan"`
+	OlderThan     time.Duration `yaml:"olderThan,omitempty" json:"olderThan"`
+	CreatedAfter  time.Time     `yaml:"createdAfter,omitempty" json:"createdAfter"`
+	CreatedBefore time.Time     `yaml:"createdBefore,omitempty" json:"createdBefore"`
+}
+
+// BatchJobTagUpdateFlags various configurations for tag update job definition
+// currently includes
+// - filter
+// - notify
+// - retry
+type BatchJobTagUpdateFlags struct {
+	Filter BatchJobTagUpdateFilter `yaml:"filter" json:"filter"`
+	Notify BatchJobNotification    `yaml:"notify" json:"notify"`
+	Retry  BatchJobRetry           `yaml:"retry" json:"retry"`
+}
+
+// BatchJobTagUpdateV1 v1 of batch tag update job
+type BatchJobTagUpdateV1 struct {
+	APIVersion string                 `yaml:"apiVersion" json:"apiVersion"`
+	Flags      BatchJobTagUpdateFlags `yaml:"flags" json:"flags"`
+	Bucket     string                 `yaml:"bucket" json:"bucket"`
+	Prefix     string                 `yaml:"prefix" json:"prefix"`
+	Tags       []BatchJobKV           `yaml:"tags" json:"tags"`
+}
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobTagUpdateV1) Notify(ctx context.Context, ri *batchJobInfo) error {
+	return notifyEndpoint(ctx, ri, r.Flags.Notify.Endpoint, r.Flags.Notify.Token)
+}
+
+// Validate validates the job definition input
+func (r *BatchJobTagUpdateV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+	if r.APIVersion != batchTagUpdateAPIVersion {
+		return errInvalidArgument
+	}
+	if r.Bucket == "" {
+		return errInvalidArgument
+	}
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		return err
+	}
+	if len(r.Tags) == 0 {
+		return errInvalidArgument
+	}
+	for _, kv := range r.Tags {
+		if kv.Key == "" {
+			return errInvalidArgument
+		}
+	}
+	return nil
+}
+
+// applyTags builds the final tag set for objInfo merged with the configured
+// tags and applies it.
+func (r *BatchJobTagUpdateV1) applyTags(ctx context.Context, api ObjectLayer, objInfo ObjectInfo) error {
+	tagMap := map[string]string{}
+	if objInfo.UserTags != "" {
+		if t, err := tags.ParseObjectTags(objInfo.UserTags); err == nil {
+			tagMap = t.ToMap()
+		}
+	}
+	for _, kv := range r.Tags {
+		tagMap[kv.Key] = kv.Value
+	}
+	newTags, err := tags.NewTags(tagMap, true)
+	if err != nil {
+		return err
+	}
+	_, err = api.PutObjectTags(ctx, r.Bucket, objInfo.Name, newTags.String(), ObjectOptions{
+		VersionID: objInfo.VersionID,
+	})
+	return err
+}
+
+const (
+	batchTagUpdateName              = "batch-tagupdate.bin"
+	batchTagUpdateFormat            = 1
+	batchTagUpdateVersionV1         = 1
+	batchTagUpdateVersion           = batchTagUpdateVersionV1
+	batchTagUpdateAPIVersion        = "v1"
+	batchTagUpdateJobDefaultRetries = 3
+	batchTagUpdateJobDefaultDelay   = 25 * time.Millisecond
+)
+
+// Start the batch tag update job, resumes if there was a pending job via "job.ID"
+func (r *BatchJobTagUpdateV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.loadOrInit(ctx, api, job); err != nil {
+		return err
+	}
+	if ri.Complete {
+		return nil
+	}
+
+	globalBatchJobsMetrics.save(job.ID, ri)
+	lastObject := ri.Object
+
+	retryAttempts := job.TagUpdate.Flags.Retry.Attempts
+	if retryAttempts <= 0 {
+		retryAttempts = batchTagUpdateJobDefaultRetries
+	}
+	delay := job.TagUpdate.Flags.Retry.Delay
+	if delay <= 0 {
+		delay = batchTagUpdateJobDefaultDelay
+	}
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	selectObj := func(info FileInfo) bool {
+		if r.Flags.Filter.OlderThan > 0 && time.Since(info.ModTime) < r.Flags.Filter.OlderThan {
+			return false
+		}
+		if r.Flags.Filter.NewerThan > 0 && time.Since(info.ModTime) >= r.Flags.Filter.NewerThan {
+			return false
+		}
+		if !r.Flags.Filter.CreatedAfter.IsZero() && info.ModTime.Before(r.Flags.Filter.CreatedAfter) {
+			return false
+		}
+		if !r.Flags.Filter.CreatedBefore.IsZero() && info.ModTime.After(r.Flags.Filter.CreatedBefore) {
+			return false
+		}
+		return true
+	}
+
+	workerSize, err := strconv.Atoi(env.Get("_MINIO_BATCH_TAGUPDATE_WORKERS", strconv.Itoa(runtime.GOMAXPROCS(0)/2)))
+	if err != nil {
+		return err
+	}
+
+	wk, err := workers.New(workerSize)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	results := make(chan itemOrErr[ObjectInfo], 100)
+	if err := api.Walk(ctx, r.Bucket, r.Prefix, results, WalkOptions{
+		Marker: lastObject,
+		Filter: selectObj,
+	}); err != nil {
+		cancel()
+		return err
+	}
+
+	failed := false
+	for res := range results {
+		if res.Err != nil {
+			failed = true
+			batchLogIf(ctx, res.Err)
+			break
+		}
+		result := res.Item
+		wk.Take()
+		go func() {
+			defer wk.Give()
+			for attempts := 1; attempts <= retryAttempts; attempts++ {
+				stopFn := globalBatchJobsMetrics.trace(batchJobMetricTagUpdate, job.ID, attempts)
+				success := true
+				if err := r.applyTags(ctx, api, result); err != nil {
+					stopFn(result, err)
+					batchLogIf(ctx, err)
+					success = false
+				} else {
+					stopFn(result, nil)
+				}
+				ri.trackCurrentBucketObject(r.Bucket, result, success, attempts)
+				globalBatchJobsMetrics.save(job.ID, ri)
+				batchLogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job))
+				if success {
+					break
+				}
+				if delay > 0 {
+					time.Sleep(delay + time.Duration(rnd.Float64()*float64(delay)))
+				}
+			}
+		}()
+	}
+	wk.Wait()
+
+	ri.Complete = !failed && ri.ObjectsFailed == 0
+	ri.Failed = failed || ri.ObjectsFailed > 0
+	globalBatchJobsMetrics.save(job.ID, ri)
+	// persist in-memory state to disk.
+	batchLogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+
+	if err := r.Notify(ctx, ri); err != nil {
+		batchLogIf(ctx, fmt.Errorf("unable to notify %v", err))
+	}
+
+	cancel()
+	return nil
+}
@@ -11,11 +11,12 @@ func _() {
 	_ = x[batchJobMetricReplication-0]
 	_ = x[batchJobMetricKeyRotation-1]
 	_ = x[batchJobMetricExpire-2]
+	_ = x[batchJobMetricTagUpdate-3]
 }
 
-const _batchJobMetric_name = "ReplicationKeyRotationExpire"
+const _batchJobMetric_name = "ReplicationKeyRotationExpireTagUpdate"
 
-var _batchJobMetric_index = [...]uint8{0, 11, 22, 28}
+var _batchJobMetric_index = [...]uint8{0, 11, 22, 28, 37}
 
 func (i batchJobMetric) String() string {
 	if i >= batchJobMetric(len(_batchJobMetric_index)-1) {
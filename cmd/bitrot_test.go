@@ -82,3 +82,45 @@ func TestAllBitrotAlgorithms(t *testing.T) {
 		testBitrotReaderWriterAlgo(t, bitrotAlgo)
 	}
 }
+
+// TestBitrotReaderReadAtOffsetOnly verifies that reading a single shard in
+// the middle of a streaming bitrot protected file does not require reading
+// (or hashing) any of the preceding shards, i.e. verification happens on
+// exactly the bytes that are read.
+func TestBitrotReaderReadAtOffsetOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	volume := "testvol"
+	filePath := "testfile"
+
+	disk, err := newLocalXLStorage(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disk.MakeVol(context.Background(), volume)
+
+	const shardSize = 10
+	writer := newBitrotWriter(disk, "", volume, filePath, 35, HighwayHash256S, shardSize)
+	for _, p := range []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc", "ddddd"} {
+		if _, err = writer.Write([]byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if bw, ok := writer.(io.Closer); ok {
+		bw.Close()
+	}
+
+	reader := newBitrotReader(disk, nil, volume, filePath, 35, HighwayHash256S, bitrotWriterSum(writer), shardSize)
+	defer closeBitrotReaders([]io.ReaderAt{reader})
+
+	// Read the third shard directly, without ever reading the first two.
+	b := make([]byte, shardSize)
+	n, err := reader.ReadAt(b, 2*shardSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b[:n]); got != "cccccccccc" {
+		t.Fatalf("unexpected shard content: got %q", got)
+	}
+}
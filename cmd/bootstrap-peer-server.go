@@ -48,6 +48,11 @@ type ServerSystemConfig struct {
 	CmdLines   []string
 	MinioEnv   map[string]string
 	Checksum   string
+	// Time is the local wall-clock time (UnixNano) at which this config was
+	// captured. Compared against the requesting node's own clock, it is
+	// used to detect inter-node clock drift that is large enough to affect
+	// signature validation or lock lease expiry.
+	Time int64
 }
 
 // Diff - returns error on first difference found in two configs.
@@ -132,7 +137,7 @@ func getServerSystemCfg() *ServerSystemConfig {
 		}
 		envValues[envK] = logger.HashString(env.Get(envK, ""))
 	}
-	scfg := &ServerSystemConfig{NEndpoints: globalEndpoints.NEndpoints(), MinioEnv: envValues, Checksum: binaryChecksum}
+	scfg := &ServerSystemConfig{NEndpoints: globalEndpoints.NEndpoints(), MinioEnv: envValues, Checksum: binaryChecksum, Time: UTCNow().UnixNano()}
 	var cmdLines []string
 	for _, ep := range globalEndpoints {
 		cmdLines = append(cmdLines, ep.CmdLine)
@@ -156,6 +161,10 @@ func registerBootstrapRESTHandlers(gm *grid.Manager) {
 // client to talk to bootstrap NEndpoints.
 type bootstrapRESTClient struct {
 	gridConn *grid.Connection
+	// endpointHost is the endpoint.Host this client talks to, i.e. the same
+	// key getLocalServerProperty uses for madmin.ServerProperties.Network,
+	// so observed clock drift can be looked back up by that key.
+	endpointHost string
 }
 
 // Verify function verifies the server config.
@@ -164,13 +173,17 @@ func (client *bootstrapRESTClient) Verify(ctx context.Context, srcCfg *ServerSys
 		return nil
 	}
 
+	sendTime := UTCNow()
 	recvCfg, err := serverVerifyHandler.Call(ctx, client.gridConn, grid.NewMSS())
 	if err != nil {
 		return err
 	}
+	rtt := UTCNow().Sub(sendTime)
 	// We do not need the response after returning.
 	defer serverVerifyHandler.PutResponse(recvCfg)
 
+	recordClockDrift(client.endpointHost, sendTime, recvCfg.Time, rtt)
+
 	return srcCfg.Diff(recvCfg)
 }
 
@@ -179,6 +192,49 @@ func (client *bootstrapRESTClient) String() string {
 	return client.gridConn.String()
 }
 
+// nodeClockDriftMu guards nodeClockDrift below.
+var nodeClockDriftMu sync.Mutex
+
+// nodeClockDrift records the most recently observed clock drift against each
+// peer contacted via bootstrapRESTClient, keyed by its endpointHost. It is
+// surfaced in ServerInfo so operators can see drift without having to search
+// server logs.
+var nodeClockDrift = map[string]time.Duration{}
+
+// recordClockDrift estimates the clock drift against peer from the
+// round-trip time of a ServerSystemConfig RPC, assuming the network latency
+// is roughly symmetric, and logs a prominent warning whenever the drift is
+// large enough to threaten signature validation or lock lease expiry
+// (DefaultSkewTime). The warning is only suppressed when its text, which
+// includes the measured drift, repeats exactly.
+func recordClockDrift(peer string, sendTime time.Time, peerTimeUnixNano int64, rtt time.Duration) {
+	drift := time.Unix(0, peerTimeUnixNano).Sub(sendTime.Add(rtt / 2))
+
+	nodeClockDriftMu.Lock()
+	nodeClockDrift[peer] = drift
+	nodeClockDriftMu.Unlock()
+
+	if absDuration(drift) > DefaultSkewTime {
+		bootLogOnceIf(context.Background(), fmt.Errorf("clock drift of %s detected against %s, exceeds tolerated skew of %s: signature validation and lock leases may be affected", drift, peer, DefaultSkewTime), "clock_drift_"+peer)
+	}
+}
+
+// clockDrift returns the most recently observed clock drift against peer, if
+// any RPC round trip has recorded one yet.
+func clockDrift(peer string) (time.Duration, bool) {
+	nodeClockDriftMu.Lock()
+	defer nodeClockDriftMu.Unlock()
+	d, ok := nodeClockDrift[peer]
+	return d, ok
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
 var binaryChecksum = getBinaryChecksum()
 
 func getBinaryChecksum() string {
@@ -269,6 +325,45 @@ func verifyServerSystemConfig(ctx context.Context, endpointServerPools EndpointS
 	return nil
 }
 
+// clockDriftCheckInterval is how often startClockDriftMonitor re-checks
+// inter-node clock drift once the cluster is up, so that drift appearing
+// after startup (e.g. an NTP daemon dying on one node) still gets caught and
+// surfaced in ServerInfo.
+var clockDriftCheckInterval = 5 * time.Minute
+
+// startClockDriftMonitor periodically re-runs the ServerSystemConfig RPC
+// round trip against every peer to keep nodeClockDrift up to date, logging a
+// prominent warning whenever drift against a peer exceeds DefaultSkewTime.
+// It blocks until ctx is canceled, so callers should run it in a goroutine.
+func startClockDriftMonitor(ctx context.Context, endpointServerPools EndpointServerPools, gm *grid.Manager) {
+	clnts := newBootstrapRESTClients(endpointServerPools, gm)
+	if len(clnts) == 0 {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(clockDriftCheckInterval):
+		}
+
+		srcCfg := getServerSystemCfg()
+		for _, clnt := range clnts {
+			if clnt.gridConn.State() != grid.StateConnected {
+				continue
+			}
+			checkCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			// Errors here are either network blips (already handled by the
+			// startup verification loop) or config drift, neither of which
+			// this periodic check needs to act on; it only cares about the
+			// clock drift recorded as a side effect of the RPC round trip.
+			_ = clnt.Verify(checkCtx, srcCfg)
+			cancel()
+		}
+	}
+}
+
 func newBootstrapRESTClients(endpointServerPools EndpointServerPools, gm *grid.Manager) []*bootstrapRESTClient {
 	seenClient := set.NewStringSet()
 	var clnts []*bootstrapRESTClient
@@ -281,7 +376,7 @@ func newBootstrapRESTClients(endpointServerPools EndpointServerPools, gm *grid.M
 				continue
 			}
 			seenClient.Add(endpoint.Host)
-			clnts = append(clnts, &bootstrapRESTClient{gm.Connection(endpoint.GridHost())})
+			clnts = append(clnts, &bootstrapRESTClient{gridConn: gm.Connection(endpoint.GridHost()), endpointHost: endpoint.Host})
 		}
 	}
 	return clnts
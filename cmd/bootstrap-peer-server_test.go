@@ -0,0 +1,65 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+// Tests that recordClockDrift estimates drift accounting for half the
+// round-trip time, and that clockDrift reports back what was recorded.
+func TestRecordClockDrift(t *testing.T) {
+	const peer = "peer1:9000"
+
+	sendTime := UTCNow()
+	rtt := 100 * time.Millisecond
+	// Simulate a peer whose clock is exactly 20 minutes ahead of ours at
+	// the midpoint of the round trip.
+	wantDrift := 20 * time.Minute
+	peerTime := sendTime.Add(rtt / 2).Add(wantDrift)
+
+	recordClockDrift(peer, sendTime, peerTime.UnixNano(), rtt)
+
+	gotDrift, ok := clockDrift(peer)
+	if !ok {
+		t.Fatal("expected a recorded drift for peer")
+	}
+	if d := absDuration(gotDrift - wantDrift); d > time.Millisecond {
+		t.Fatalf("expected drift close to %s, got %s", wantDrift, gotDrift)
+	}
+
+	if _, ok = clockDrift("unknown-peer:9000"); ok {
+		t.Fatal("expected no recorded drift for a peer that was never checked")
+	}
+}
+
+func TestAbsDuration(t *testing.T) {
+	testCases := []struct {
+		in, want time.Duration
+	}{
+		{time.Minute, time.Minute},
+		{-time.Minute, time.Minute},
+		{0, 0},
+	}
+	for i, testCase := range testCases {
+		if got := absDuration(testCase.in); got != testCase.want {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.want, got)
+		}
+	}
+}
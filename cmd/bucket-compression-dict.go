@@ -0,0 +1,146 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"path"
+)
+
+const (
+	bucketCompressionDictConfigFile = "compression-dictionary.json"
+
+	// maxCompressionDictSize bounds the size of a trained dictionary kept in
+	// bucket metadata.
+	maxCompressionDictSize = 32 << 10
+)
+
+var errCompressionDictTooLarge = errors.New("compression dictionary exceeds maximum allowed size")
+
+// BucketCompressionDictConfig holds a trained compression dictionary applied
+// to small, homogeneous objects (e.g. JSON/log lines) stored in a bucket.
+// Objects smaller than MaxObjectSize are compressed against Dictionary
+// instead of the default codec, improving ratios for small, repetitive
+// payloads where a standalone codec has little context to work with.
+type BucketCompressionDictConfig struct {
+	Enabled       bool   `json:"enabled"`
+	Dictionary    []byte `json:"dictionary"`
+	MaxObjectSize int64  `json:"maxObjectSize"`
+}
+
+// Validate checks that the dictionary configuration is usable.
+func (cfg BucketCompressionDictConfig) Validate() error {
+	if len(cfg.Dictionary) > maxCompressionDictSize {
+		return errCompressionDictTooLarge
+	}
+	if cfg.MaxObjectSize < 0 {
+		return errInvalidArgument
+	}
+	return nil
+}
+
+func bucketCompressionDictConfigPath(bucket string) string {
+	return path.Join(bucketMetaPrefix, bucket, bucketCompressionDictConfigFile)
+}
+
+// saveBucketCompressionDictConfig persists the dictionary configuration.
+func saveBucketCompressionDictConfig(ctx context.Context, objAPI ObjectLayer, bucket string, cfg BucketCompressionDictConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, bucketCompressionDictConfigPath(bucket), data)
+}
+
+// getBucketCompressionDictConfig returns the configured dictionary for
+// bucket, if any.
+func getBucketCompressionDictConfig(ctx context.Context, objAPI ObjectLayer, bucket string) (BucketCompressionDictConfig, error) {
+	data, err := readConfig(ctx, objAPI, bucketCompressionDictConfigPath(bucket))
+	if err != nil {
+		return BucketCompressionDictConfig{}, err
+	}
+	var cfg BucketCompressionDictConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return BucketCompressionDictConfig{}, err
+	}
+	return cfg, nil
+}
+
+// deleteBucketCompressionDictConfig removes the dictionary configuration.
+func deleteBucketCompressionDictConfig(ctx context.Context, objAPI ObjectLayer, bucket string) error {
+	err := deleteConfig(ctx, objAPI, bucketCompressionDictConfigPath(bucket))
+	if errors.Is(err, errConfigNotFound) {
+		return nil
+	}
+	return err
+}
+
+// trainCompressionDict builds a naive dictionary out of a set of sample
+// payloads by concatenating them, most-recently-seen last (closest to the
+// window used by the deflate algorithm), and truncating to maxSize. Callers
+// are expected to pass representative samples of objects already stored in
+// the bucket (e.g. via the scanner or a batch listing).
+func trainCompressionDict(samples [][]byte, maxSize int) []byte {
+	if maxSize <= 0 || maxSize > maxCompressionDictSize {
+		maxSize = maxCompressionDictSize
+	}
+	var buf bytes.Buffer
+	for _, s := range samples {
+		buf.Write(s)
+	}
+	dict := buf.Bytes()
+	if len(dict) > maxSize {
+		dict = dict[len(dict)-maxSize:]
+	}
+	return dict
+}
+
+// compressWithDict compresses data using DEFLATE seeded with dict as a
+// preset dictionary, giving small, repetitive objects (e.g. JSON or log
+// lines sharing field names) a much larger compression window than their
+// own body would otherwise provide.
+func compressWithDict(data, dict []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriterDict(&buf, flate.DefaultCompression, dict)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressWithDict reverses compressWithDict using the same dictionary.
+func decompressWithDict(data, dict []byte) ([]byte, error) {
+	r := flate.NewReaderDict(bytes.NewReader(data), dict)
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -19,7 +19,13 @@ package cmd
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/kms"
 )
 
 func TestValidateBucketSSEConfig(t *testing.T) {
@@ -68,3 +74,101 @@ func TestValidateBucketSSEConfig(t *testing.T) {
 		}
 	}
 }
+
+// Wrapper for calling PutBucketEncryption, GetBucketEncryption and
+// DeleteBucketEncryption HTTP handler tests for both Erasure multiple disks
+// and single node setup.
+func TestBucketEncryptionHandlers(t *testing.T) {
+	ExecObjectLayerAPITest(ExecObjectLayerAPITestArgs{t: t, objAPITest: testBucketEncryptionHandlers, endpoints: []string{"PutBucketEncryption", "GetBucketEncryption", "DeleteBucketEncryption"}})
+}
+
+// testBucketEncryptionHandlers - Tests the end to end flow of setting,
+// fetching and deleting the default bucket encryption configuration.
+func testBucketEncryptionHandlers(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	// PutBucketEncryption fails without a configured KMS, set up a stub one
+	// for the duration of this test.
+	prevKMS := GlobalKMS
+	GlobalKMS = kms.NewStub("default-test-key")
+	defer func() { GlobalKMS = prevKMS }()
+
+	sseConfig := `<ServerSideEncryptionConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`
+
+	// GetBucketEncryption on a bucket without any configuration should
+	// fail with a "no such configuration" error.
+	recGet := httptest.NewRecorder()
+	reqGet, err := newTestSignedRequestV4(http.MethodGet, getGetBucketEncryptionURL("", bucketName),
+		0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recGet, reqGet)
+	if recGet.Code != http.StatusNotFound {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusNotFound, recGet.Code)
+	}
+
+	// PutBucketEncryption should persist the configuration.
+	recPut := httptest.NewRecorder()
+	reqPut, err := newTestSignedRequestV4(http.MethodPut, getPutBucketEncryptionURL("", bucketName),
+		int64(len(sseConfig)), bytes.NewReader([]byte(sseConfig)), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for PutBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recPut, reqPut)
+	if recPut.Code != http.StatusOK {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusOK, recPut.Code)
+	}
+
+	// GetBucketEncryption should now return the configuration just set.
+	recGet2 := httptest.NewRecorder()
+	reqGet2, err := newTestSignedRequestV4(http.MethodGet, getGetBucketEncryptionURL("", bucketName),
+		0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recGet2, reqGet2)
+	if recGet2.Code != http.StatusOK {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusOK, recGet2.Code)
+	}
+	if got, err := validateBucketSSEConfig(recGet2.Body); err != nil || got.Rules[0].DefaultEncryptionAction.Algorithm != "AES256" {
+		t.Fatalf("Unexpected bucket encryption configuration returned: %v (err: %v)", got, err)
+	}
+
+	// DeleteBucketEncryption should remove the configuration.
+	recDel := httptest.NewRecorder()
+	reqDel, err := newTestSignedRequestV4(http.MethodDelete, getDeleteBucketEncryptionURL("", bucketName),
+		0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recDel, reqDel)
+	if recDel.Code != http.StatusNoContent {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusNoContent, recDel.Code)
+	}
+
+	// GetBucketEncryption should fail again after the delete.
+	recGet3 := httptest.NewRecorder()
+	reqGet3, err := newTestSignedRequestV4(http.MethodGet, getGetBucketEncryptionURL("", bucketName),
+		0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recGet3, reqGet3)
+	if recGet3.Code != http.StatusNotFound {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusNotFound, recGet3.Code)
+	}
+
+	// Non-existent bucket should return a 404 on PutBucketEncryption.
+	nonExistentBucket := fmt.Sprintf("%s-non-existent", bucketName)
+	recPutMissing := httptest.NewRecorder()
+	reqPutMissing, err := newTestSignedRequestV4(http.MethodPut, getPutBucketEncryptionURL("", nonExistentBucket),
+		int64(len(sseConfig)), bytes.NewReader([]byte(sseConfig)), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for PutBucketEncryptionHandler: <ERROR> %v", err)
+	}
+	apiRouter.ServeHTTP(recPutMissing, reqPutMissing)
+	if recPutMissing.Code != http.StatusNotFound {
+		t.Fatalf("Expected response status to be `%d`, but instead found `%d`", http.StatusNotFound, recPutMissing.Code)
+	}
+}
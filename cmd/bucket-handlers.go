@@ -359,7 +359,11 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
-	if s3Error == ErrAccessDenied {
+	// Non-owner credentials only ever see the buckets they have permission
+	// for, whether or not s3:ListAllMyBuckets itself was granted -- a broad
+	// grant of that action (e.g. via a wildcard resource) must not leak the
+	// names of buckets the policy otherwise restricts access to.
+	if !owner {
 		// Set prefix value for "s3:prefix" policy conditionals.
 		r.Header.Set("prefix", "")
 
@@ -397,8 +401,11 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 			}
 		}
 		bucketsInfo = bucketsInfo[:n]
-		// No buckets can be filtered return access denied error.
-		if len(bucketsInfo) == 0 {
+		// The caller never had s3:ListAllMyBuckets to begin with and no
+		// individual bucket passed the fallback checks either -- return
+		// access denied as before. If s3:ListAllMyBuckets was allowed but
+		// filtering still dropped every bucket, that's just an empty list.
+		if len(bucketsInfo) == 0 && s3Error == ErrAccessDenied {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
 			return
 		}
@@ -702,7 +709,7 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			BucketName:   bucket,
 			Object:       objInfo,
 			ReqParams:    extractReqParams(r),
-			RespElements: extractRespElements(w),
+			RespElements: extractRespElements(w, r),
 			UserAgent:    r.UserAgent(),
 			Host:         handlers.GetSourceIP(r),
 		})
@@ -832,7 +839,7 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 					EventName:    event.BucketCreated,
 					BucketName:   bucket,
 					ReqParams:    extractReqParams(r),
-					RespElements: extractRespElements(w),
+					RespElements: extractRespElements(w, r),
 					UserAgent:    r.UserAgent(),
 					Host:         handlers.GetSourceIP(r),
 				})
@@ -883,7 +890,7 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 		EventName:    event.BucketCreated,
 		BucketName:   bucket,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -1132,6 +1139,12 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 	object := trimLeadingSlash(formValues.Get("Key"))
 
 	successRedirect := formValues.Get("success_action_redirect")
+	if successRedirect == "" {
+		// "redirect" is accepted as a legacy alias for success_action_redirect,
+		// it is already a recognized policy condition key (see startsWithConds)
+		// but was never actually consulted here.
+		successRedirect = formValues.Get("redirect")
+	}
 	successStatus := formValues.Get("success_action_status")
 	var redirectURL *url.URL
 	if successRedirect != "" {
@@ -1159,7 +1172,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 			ConditionValues: getConditionValues(r, "", cred),
 			BucketName:      bucket,
 			ObjectName:      object,
-			IsOwner:         globalActiveCred.AccessKey == cred.AccessKey,
+			IsOwner:         globalActiveCred().AccessKey == cred.AccessKey,
 			Claims:          cred.Claims,
 		}) {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
@@ -1175,7 +1188,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 			ConditionValues: getConditionValues(r, "", cred),
 			BucketName:      bucket,
 			ObjectName:      object,
-			IsOwner:         globalActiveCred.AccessKey == cred.AccessKey,
+			IsOwner:         globalActiveCred().AccessKey == cred.AccessKey,
 			Claims:          cred.Claims,
 		}) {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
@@ -1413,7 +1426,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 						BucketName:   objInfo.Bucket,
 						Object:       ObjectInfo{Name: objInfo.Name},
 						ReqParams:    extractReqParams(r),
-						RespElements: extractRespElements(w),
+						RespElements: extractRespElements(w, r),
 						UserAgent:    fmt.Sprintf("%s MinIO-Fan-Out (failed: %v)", r.UserAgent(), errs[i]),
 						Host:         handlers.GetSourceIP(r),
 					})
@@ -1432,7 +1445,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 					BucketName:   objInfo.Bucket,
 					Object:       objInfo,
 					ReqParams:    extractReqParams(r),
-					RespElements: extractRespElements(w),
+					RespElements: extractRespElements(w, r),
 					UserAgent:    r.UserAgent() + " " + "MinIO-Fan-Out",
 					Host:         handlers.GetSourceIP(r),
 				})
@@ -1460,7 +1473,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 					BucketName:   eventArgsList[i].Object.Bucket,
 					Object:       eventArgsList[i].Object,
 					ReqParams:    extractReqParams(r),
-					RespElements: extractRespElements(w),
+					RespElements: extractRespElements(w, r),
 					UserAgent:    r.UserAgent() + " " + "MinIO-Fan-Out",
 					Host:         handlers.GetSourceIP(r),
 				})
@@ -1520,7 +1533,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		BucketName:   objInfo.Bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -1531,7 +1544,7 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 			BucketName:   objInfo.Bucket,
 			Object:       objInfo,
 			ReqParams:    extractReqParams(r),
-			RespElements: extractRespElements(w),
+			RespElements: extractRespElements(w, r),
 			UserAgent:    r.UserAgent(),
 			Host:         handlers.GetSourceIP(r),
 		})
@@ -1773,7 +1786,7 @@ func (api objectAPIHandlers) DeleteBucketHandler(w http.ResponseWriter, r *http.
 		EventName:    event.BucketRemoved,
 		BucketName:   bucket,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -19,15 +19,19 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/pkg/v3/policy"
 )
 
 // Wrapper for calling RemoveBucket HTTP handler tests for both Erasure multiple disks and single node setup.
@@ -633,6 +637,66 @@ func testListBucketsHandler(obj ObjectLayer, instanceType, bucketName string, ap
 	// unsigned request goes through and its validated again.
 	ExecObjectLayerAPIAnonTest(t, obj, "ListBucketsHandler", "", "", instanceType, apiRouter, anonReq, getAnonWriteOnlyBucketPolicy("*"))
 
+	// A non-owner credential with a broad s3:ListAllMyBuckets grant (e.g. via
+	// a wildcard resource) must still only see the buckets it individually
+	// has s3:ListBucket (or s3:GetBucketLocation) on - a grant of
+	// ListAllMyBuckets itself must not leak every bucket name.
+	otherBucketName := getRandomBucketName()
+	if err := obj.MakeBucket(context.Background(), otherBucketName, MakeBucketOptions{}); err != nil {
+		t.Fatalf("%s: Failed to create second bucket: <ERROR> %v", instanceType, err)
+	}
+
+	limitedCreds, err := auth.CreateCredentials("limiteduser", "limitedpassword1")
+	if err != nil {
+		t.Fatalf("%s: Failed to create credentials: <ERROR> %v", instanceType, err)
+	}
+	if _, err = globalIAMSys.CreateUser(context.Background(), limitedCreds.AccessKey, madmin.AddOrUpdateUserReq{
+		SecretKey: limitedCreds.SecretKey,
+		Status:    madmin.AccountEnabled,
+	}); err != nil {
+		t.Fatalf("%s: Failed to create user: <ERROR> %v", instanceType, err)
+	}
+
+	limitedPolicyName := "testListBucketsLimitedPolicy"
+	limitedPolicyData, err := policy.ParseConfig(strings.NewReader(fmt.Sprintf(`{"Version":"2012-10-17","Statement":[
+		{"Effect":"Allow","Action":["s3:ListAllMyBuckets"],"Resource":["arn:aws:s3:::*"]},
+		{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::%s"]}
+	]}`, bucketName)))
+	if err != nil {
+		t.Fatalf("%s: Failed to parse policy: <ERROR> %v", instanceType, err)
+	}
+	if _, err = globalIAMSys.SetPolicy(context.Background(), limitedPolicyName, *limitedPolicyData); err != nil {
+		t.Fatalf("%s: Failed to set policy: <ERROR> %v", instanceType, err)
+	}
+	if _, err = globalIAMSys.PolicyDBSet(context.Background(), limitedCreds.AccessKey, limitedPolicyName, regUser, false); err != nil {
+		t.Fatalf("%s: Failed to attach policy: <ERROR> %v", instanceType, err)
+	}
+
+	limitedReq, err := newTestSignedRequestV4(http.MethodGet, getListBucketURL(""), 0, nil, limitedCreds.AccessKey, limitedCreds.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("%s: Failed to create HTTP request for ListBucketsHandler: <ERROR> %v", instanceType, err)
+	}
+	limitedRec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(limitedRec, limitedReq)
+	if limitedRec.Code != http.StatusOK {
+		t.Fatalf("%s: Expected response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, limitedRec.Code)
+	}
+
+	var limitedResp ListBucketsResponse
+	if err = xml.Unmarshal(limitedRec.Body.Bytes(), &limitedResp); err != nil {
+		t.Fatalf("%s: Failed to unmarshal response: <ERROR> %v", instanceType, err)
+	}
+	seen := map[string]bool{}
+	for _, b := range limitedResp.Buckets.Buckets {
+		seen[b.Name] = true
+	}
+	if !seen[bucketName] {
+		t.Errorf("%s: Expected listing to include %s, got %v", instanceType, bucketName, seen)
+	}
+	if seen[otherBucketName] {
+		t.Errorf("%s: Expected listing to exclude %s despite the broad ListAllMyBuckets grant, got %v", instanceType, otherBucketName, seen)
+	}
+
 	// HTTP request for testing when `objectLayer` is set to `nil`.
 	// There is no need to use an existing bucket and valid input for creating the request
 	// since the `objectLayer==nil`  check is performed before any other checks inside the handlers.
@@ -317,6 +317,12 @@ func (es *expiryState) ResizeWorkers(n int) {
 	es.workers.Store(&workers)
 }
 
+// lifecycleExpiryBatchSize caps how many same-bucket, same-action expiry
+// tasks are grouped into a single bulk DeleteObjects call by Worker, so a
+// burst of expirations for one bucket doesn't hold a single bulk namespace
+// lock for an unbounded number of objects at once.
+const lifecycleExpiryBatchSize = 100
+
 // Worker handles 4 types of expiration tasks.
 // 1. Expiry of objects, includes regular and transitioned objects
 // 2. Expiry of noncurrent versions due to NewerNoncurrentVersions
@@ -324,30 +330,64 @@ func (es *expiryState) ResizeWorkers(n int) {
 // 4. Expiry of remote objects corresponding to objects in a
 // non-versioned/version suspended buckets
 func (es *expiryState) Worker(input <-chan expiryOp) {
+	// pending holds batchable expiryTasks accumulated so far, all sharing
+	// pendingBucket/pendingEvent.Action/pendingSrc, waiting to be flushed
+	// together as a single bulk delete instead of one per object.
+	var (
+		pendingBucket string
+		pendingEvent  lifecycle.Event
+		pendingSrc    lcEventSrc
+		pending       []expiryTask
+	)
+	flushPending := func() {
+		if len(pending) == 0 {
+			return
+		}
+		expireObjectBatch(es.ctx, es.objAPI, pendingBucket, pending, pendingEvent, pendingSrc)
+		pending = nil
+	}
+
 	for {
 		select {
 		case <-es.ctx.Done():
 			return
 		case v, ok := <-input:
 			if !ok {
+				flushPending()
 				return
 			}
 			if v == nil {
 				// ResizeWorkers signaling worker to quit
+				flushPending()
 				return
 			}
 			switch v := v.(type) {
 			case expiryTask:
-				if v.objInfo.TransitionedObject.Status != "" {
-					applyExpiryOnTransitionedObject(es.ctx, es.objAPI, v.objInfo, v.event, v.src)
-				} else {
-					applyExpiryOnNonTransitionedObjects(es.ctx, es.objAPI, v.objInfo, v.event, v.src)
+				if !expiryBatchable(v) {
+					flushPending()
+					if v.objInfo.TransitionedObject.Status != "" {
+						applyExpiryOnTransitionedObject(es.ctx, es.objAPI, v.objInfo, v.event, v.src)
+					} else {
+						applyExpiryOnNonTransitionedObjects(es.ctx, es.objAPI, v.objInfo, v.event, v.src)
+					}
+					continue
+				}
+				if len(pending) > 0 && (pendingBucket != v.objInfo.Bucket || pendingEvent.Action != v.event.Action || pendingSrc != v.src) {
+					flushPending()
+				}
+				pendingBucket, pendingEvent, pendingSrc = v.objInfo.Bucket, v.event, v.src
+				pending = append(pending, v)
+				if len(pending) >= lifecycleExpiryBatchSize {
+					flushPending()
 				}
 			case newerNoncurrentTask:
+				flushPending()
 				deleteObjectVersions(es.ctx, es.objAPI, v.bucket, v.versions, v.event)
 			case jentry:
+				flushPending()
 				transitionLogIf(es.ctx, deleteObjectFromRemoteTier(es.ctx, v.ObjName, v.VersionID, v.TierName))
 			case freeVersionTask:
+				flushPending()
 				oi := v.ObjectInfo
 				traceFn := globalLifecycleSys.trace(oi)
 				if !oi.TransitionedObject.FreeVersion {
@@ -382,6 +422,7 @@ func (es *expiryState) Worker(input <-chan expiryOp) {
 					transitionLogIf(es.ctx, err)
 				}
 			default:
+				flushPending()
 				bugLogIf(es.ctx, fmt.Errorf("Invalid work type - %v", v))
 			}
 		}
@@ -619,9 +660,18 @@ func expireTransitionedObject(ctx context.Context, objectAPI ObjectLayer, oi *Ob
 		opts.Transition.ExpireRestored = true
 		_, err := objectAPI.DeleteObject(ctx, oi.Bucket, oi.Name, opts)
 		if err == nil {
-			// TODO consider including expiry of restored object to events we
-			// notify.
 			auditLogLifecycle(ctx, *oi, ILMExpiry, tags, traceFn)
+			// Notify object restore expiry event.
+			sendEvent(eventArgs{
+				EventName:  event.ObjectRemovedDelete,
+				BucketName: oi.Bucket,
+				Object: ObjectInfo{
+					Name:      oi.Name,
+					VersionID: oi.VersionID,
+				},
+				UserAgent: "Internal: [ILM-Expiry]",
+				Host:      globalLocalNodeName,
+			})
 		}
 		return err
 	}
@@ -745,6 +795,15 @@ func auditTierActions(ctx context.Context, tier string, bytes int64) func(err er
 }
 
 // getTransitionedObjectReader returns a reader from the transitioned tier.
+//
+// A multipart upload that was later transitioned is stored remotely as a
+// single composite object, but oi.Parts still records its original part
+// boundaries, so a Range GET for a given opts.PartNumber maps onto the
+// correct byte sub-range of that composite object exactly as it would for a
+// non-transitioned object: NewGetObjectReader below resolves opts.PartNumber
+// to a byte range via partNumberToRangeSpec before it ever reaches the tier,
+// and the x-amz-mp-parts-count response header is set from oi.Parts by the
+// caller independently of which tier currently holds the data.
 func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, oi ObjectInfo, opts ObjectOptions) (gr *GetObjectReader, err error) {
 	tgtClient, err := globalTierConfigMgr.getDriver(ctx, oi.TransitionedObject.Tier)
 	if err != nil {
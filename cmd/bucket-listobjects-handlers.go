@@ -22,13 +22,27 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 
 	"github.com/minio/pkg/v3/policy"
 )
 
+// xMinIOFetchMetadata opts a standard ListObjectsV2/ListObjectVersions
+// request into the same user metadata and tag enrichment as the dedicated
+// "M" routes, saving clients a HEAD call per listed object.
+const xMinIOFetchMetadata = "x-minio-fetch-metadata"
+
+// xMinIOBestEffortListing opts a listing request into best-effort mode:
+// if fewer than quorum drives can be reached, the listing is served from
+// whichever drives did respond instead of failing, and the response carries
+// xhttp.MinIODegradedListing so the client knows the result may be missing
+// entries that only exist on the unreachable drives.
+const xMinIOBestEffortListing = "x-minio-best-effort-listing"
+
 // Validate all the ListObjects query arguments, returns an APIErrorCode
 // if one of the args do not meet the required conditions.
 // Special conditions required by MinIO server are as below
@@ -88,6 +102,12 @@ func (api objectAPIHandlers) listObjectVersionsHandler(w http.ResponseWriter, r
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
 		return
 	}
+
+	// Allow opting into the same metadata/tag enrichment as
+	// ListObjectVersionsM on the standard route via a MinIO-specific
+	// header, so clients do not need to know about the separate "M" route.
+	metadata = metadata || r.Header.Get(xMinIOFetchMetadata) == "true"
+
 	var checkObjMeta metaCheckFn
 	if metadata {
 		checkObjMeta = func(name string, action policy.Action) (s3Err APIErrorCode) {
@@ -111,6 +131,11 @@ func (api objectAPIHandlers) listObjectVersionsHandler(w http.ResponseWriter, r
 
 	listObjectVersions := objectAPI.ListObjectVersions
 
+	var degraded *atomic.Bool
+	if r.Header.Get(xMinIOBestEffortListing) == "true" {
+		ctx, degraded = withBestEffortListing(ctx)
+	}
+
 	// Initiate a list object versions operation based on the input params.
 	// On success would return back ListObjectsInfo object to be
 	// marshaled into S3 compatible XML header.
@@ -126,6 +151,10 @@ func (api objectAPIHandlers) listObjectVersionsHandler(w http.ResponseWriter, r
 	}
 	response := generateListVersionsResponse(ctx, bucket, prefix, marker, versionIDMarker, delimiter, encodingType, maxkeys, listObjectVersionsInfo, checkObjMeta)
 
+	if degraded != nil && degraded.Load() {
+		w.Header().Set(xhttp.MinIODegradedListing, "true")
+	}
+
 	// Write success response.
 	writeSuccessResponseXML(w, encodeResponseList(response))
 }
@@ -174,6 +203,11 @@ func (api objectAPIHandlers) listObjectsV2Handler(ctx context.Context, w http.Re
 		return
 	}
 
+	// Allow opting into the same metadata/tag enrichment as ListObjectsV2M
+	// on the standard ListObjectsV2 route via a MinIO-specific header, so
+	// clients do not need to know about the separate "M" route.
+	metadata = metadata || r.Header.Get(xMinIOFetchMetadata) == "true"
+
 	var checkObjMeta metaCheckFn
 	if metadata {
 		checkObjMeta = func(name string, action policy.Action) (s3Err APIErrorCode) {
@@ -198,8 +232,13 @@ func (api objectAPIHandlers) listObjectsV2Handler(ctx context.Context, w http.Re
 	var (
 		listObjectsV2Info ListObjectsV2Info
 		err               error
+		degraded          *atomic.Bool
 	)
 
+	if r.Header.Get(xMinIOBestEffortListing) == "true" {
+		ctx, degraded = withBestEffortListing(ctx)
+	}
+
 	if r.Header.Get(xMinIOExtract) == "true" && strings.Contains(prefix, archivePattern) {
 		// Initiate a list objects operation inside a zip file based in the input params
 		listObjectsV2Info, err = listObjectsV2InArchive(ctx, objectAPI, bucket, prefix, token, delimiter, maxKeys, startAfter, r.Header)
@@ -223,6 +262,10 @@ func (api objectAPIHandlers) listObjectsV2Handler(ctx context.Context, w http.Re
 		delimiter, encodingType, fetchOwner, listObjectsV2Info.IsTruncated,
 		maxKeys, listObjectsV2Info.Objects, listObjectsV2Info.Prefixes, checkObjMeta)
 
+	if degraded != nil && degraded.Load() {
+		w.Header().Set(xhttp.MinIODegradedListing, "true")
+	}
+
 	// Write success response.
 	writeSuccessResponseXML(w, encodeResponseList(response))
 }
@@ -304,6 +347,11 @@ func (api objectAPIHandlers) ListObjectsV1Handler(w http.ResponseWriter, r *http
 
 	listObjects := objectAPI.ListObjects
 
+	var degraded *atomic.Bool
+	if r.Header.Get(xMinIOBestEffortListing) == "true" {
+		ctx, degraded = withBestEffortListing(ctx)
+	}
+
 	// Initiate a list objects operation based on the input params.
 	// On success would return back ListObjectsInfo object to be
 	// marshaled into S3 compatible XML header.
@@ -320,6 +368,10 @@ func (api objectAPIHandlers) ListObjectsV1Handler(w http.ResponseWriter, r *http
 
 	response := generateListObjectsV1Response(ctx, bucket, prefix, marker, delimiter, encodingType, maxKeys, listObjectsInfo)
 
+	if degraded != nil && degraded.Load() {
+		w.Header().Set(xhttp.MinIODegradedListing, "true")
+	}
+
 	// Write success response.
 	writeSuccessResponseXML(w, encodeResponseList(response))
 }
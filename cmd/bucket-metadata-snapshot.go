@@ -0,0 +1,94 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// bucketMetadataSnapshotFile is the name of the node-local warm-start
+// snapshot written on a clean shutdown. Unlike bucketMetadataFile, which is
+// the authoritative copy stored per-bucket on the backend, this is a single
+// best-effort local cache: on the next startup it lets requests be served
+// immediately while the authoritative per-bucket metadata is reloaded from
+// the backend in the background, instead of blocking startup on it.
+const bucketMetadataSnapshotFile = "bucket-metadata-snapshot.json"
+
+func getBucketMetadataSnapshotFile() string {
+	return filepath.Join(globalConfigDir.Get(), bucketMetadataSnapshotFile)
+}
+
+// saveSnapshot persists the current in-memory bucket metadata map to the
+// node-local snapshot file. It is best-effort: callers only invoke this on a
+// clean shutdown and log, rather than act on, any error.
+func (sys *BucketMetadataSys) saveSnapshot() error {
+	sys.RLock()
+	metas := make([]BucketMetadata, 0, len(sys.metadataMap))
+	for _, meta := range sys.metadataMap {
+		metas = append(metas, meta)
+	}
+	sys.RUnlock()
+
+	data, err := json.Marshal(metas)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(getBucketMetadataSnapshotFile(), data, 0o600)
+}
+
+// loadSnapshot reads back a previously saved snapshot, restricted to the
+// buckets that still exist on the backend, and parses every config so the
+// returned metadata is immediately usable. ok is false if no usable snapshot
+// is available, in which case callers should fall back to a normal load.
+func (sys *BucketMetadataSys) loadSnapshot(ctx context.Context, buckets []string) (metadataMap map[string]BucketMetadata, ok bool) {
+	data, err := os.ReadFile(getBucketMetadataSnapshotFile())
+	if err != nil {
+		return nil, false
+	}
+
+	var metas []BucketMetadata
+	if err = json.Unmarshal(data, &metas); err != nil {
+		return nil, false
+	}
+
+	existing := make(map[string]struct{}, len(buckets))
+	for _, bucket := range buckets {
+		existing[bucket] = struct{}{}
+	}
+
+	metadataMap = make(map[string]BucketMetadata, len(metas))
+	for _, meta := range metas {
+		if _, found := existing[meta.Name]; !found {
+			// Bucket was removed since the snapshot was taken.
+			continue
+		}
+		if err := meta.parseAllConfigs(ctx, sys.objAPI); err != nil {
+			// A single corrupt entry shouldn't throw away the rest of the
+			// snapshot; this bucket will simply wait for the background
+			// authoritative reload like it would on a cold start.
+			continue
+		}
+		metadataMap[meta.Name] = meta
+	}
+
+	return metadataMap, len(metadataMap) > 0
+}
@@ -501,6 +501,29 @@ func (sys *BucketMetadataSys) Init(ctx context.Context, buckets []string, objAPI
 
 	sys.objAPI = objAPI
 
+	// Warm start: if a snapshot from a previous clean shutdown is present,
+	// serve from it immediately and let the authoritative per-bucket load
+	// from the backend catch up in the background, instead of blocking
+	// startup on it. This only ever shortens the window before requests can
+	// be served; the background load still runs to completion and overwrites
+	// every entry with authoritative data.
+	if snap, ok := sys.loadSnapshot(ctx, buckets); ok {
+		bootstrapTraceMsg("globalBucketMetadataSys.Init: warm start from local snapshot")
+
+		sys.Lock()
+		sys.metadataMap = snap
+		sys.initialized = true
+		sys.Unlock()
+
+		for bucket, meta := range snap {
+			globalEventNotifier.set(bucket, meta)
+			globalBucketTargetSys.set(bucket, meta)
+		}
+
+		go sys.init(ctx, buckets)
+		return nil
+	}
+
 	// Load bucket metadata sys.
 	sys.init(ctx, buckets)
 	return nil
@@ -626,12 +649,24 @@ func (sys *BucketMetadataSys) Initialized() bool {
 // Loads bucket metadata for all buckets into BucketMetadataSys.
 func (sys *BucketMetadataSys) init(ctx context.Context, buckets []string) {
 	count := globalEndpoints.ESCount() * 10
+	total := len(buckets)
+	loaded := 0
 	for {
-		if len(buckets) < count {
-			sys.concurrentLoad(ctx, buckets)
+		batch := buckets
+		if len(batch) > count {
+			batch = buckets[:count]
+		}
+		sys.concurrentLoad(ctx, batch)
+		loaded += len(batch)
+		if total > count {
+			// On deployments with many buckets this loop can take a while;
+			// surface progress on the bootstrap trace so it doesn't look
+			// like startup is hung while later batches are still loading.
+			bootstrapTraceMsg(fmt.Sprintf("globalBucketMetadataSys.Init: loaded %d/%d buckets", loaded, total))
+		}
+		if len(buckets) <= count {
 			break
 		}
-		sys.concurrentLoad(ctx, buckets[:count])
 		buckets = buckets[count:]
 	}
 
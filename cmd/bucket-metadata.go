@@ -337,6 +337,7 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 			return err
 		}
 	}
+	updateBucketBandwidthLimit(b.Name, b.quotaConfig)
 
 	if len(b.ReplicationConfigXML) != 0 {
 		b.replicationConfig, err = replication.ParseConfig(bytes.NewReader(b.ReplicationConfigXML))
@@ -18,12 +18,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"io"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/handlers"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 	"github.com/minio/pkg/v3/policy"
@@ -161,3 +164,94 @@ func (api objectAPIHandlers) PutBucketNotificationHandler(w http.ResponseWriter,
 
 	writeSuccessResponseHeadersOnly(w)
 }
+
+// ReplayEventsResult - outcome of a notification replay request.
+type ReplayEventsResult struct {
+	EventsSent int `json:"eventsSent"`
+}
+
+// ReplayBucketEventsHandler - re-emits s3:ObjectCreated:Put notification
+// events for objects already in the bucket, so a newly added notification
+// target can be backfilled instead of only seeing events for objects
+// created from now on.
+//
+// Only delivered to targets whose existing notification rules match the
+// event and object key - this does not bypass or change the bucket's
+// notification configuration in any way, it only replays history against it.
+//
+// This API is a MinIO extension.
+func (api objectAPIHandlers) ReplayBucketEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ReplayBucketEvents")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucketName := vars["bucket"]
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketNotificationAction, bucketName, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucketName, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+
+	var after time.Time
+	if afterStr := r.URL.Query().Get("after"); afterStr != "" {
+		var err error
+		after, err = time.Parse(time.RFC3339, afterStr)
+		if err != nil {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+	}
+
+	var eventsSent int
+	marker := ""
+	for {
+		result, err := objectAPI.ListObjects(ctx, bucketName, prefix, marker, "", maxObjectList)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+
+		for _, objInfo := range result.Objects {
+			if objInfo.ModTime.Before(after) {
+				continue
+			}
+
+			sendEvent(eventArgs{
+				EventName:  event.ObjectCreatedPut,
+				BucketName: bucketName,
+				Object:     objInfo,
+				ReqParams:  extractReqParams(r),
+				UserAgent:  r.UserAgent(),
+				Host:       handlers.GetSourceIP(r),
+			})
+			eventsSent++
+		}
+
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	data, err := json.Marshal(ReplayEventsResult{EventsSent: eventsSent})
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
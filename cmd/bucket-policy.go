@@ -94,7 +94,7 @@ func getConditionValues(r *http.Request, lc string, cred auth.Credentials) map[s
 		if len(claims) > 0 {
 			principalType = "AssumedRole"
 		}
-		if username == globalActiveCred.AccessKey {
+		if username == globalActiveCred().AccessKey {
 			principalType = "Account"
 		}
 	}
@@ -150,6 +150,12 @@ func getConditionValues(r *http.Request, lc string, cred auth.Credentials) map[s
 		cloneHeader.Del("x-amz-signature-age")
 	}
 
+	for _, group := range groups {
+		for k, v := range globalIAMSys.GroupTags(group) {
+			args[pathJoin("GroupTag", k)] = []string{v}
+		}
+	}
+
 	if userTags := cloneHeader.Get(xhttp.AmzObjectTagging); userTags != "" {
 		tag, _ := tags.ParseObjectTags(userTags)
 		if tag != nil {
@@ -100,6 +100,19 @@ func parseBucketQuota(bucket string, data []byte) (quotaCfg *madmin.BucketQuota,
 	return
 }
 
+// updateBucketBandwidthLimit enforces the bucket quota's Rate (bytes/sec) as
+// an ingress/egress throttle on the bucket, reusing the same bandwidth
+// monitor that throttles replication traffic. It is keyed with an empty
+// replication ARN so it never collides with a per-target replication
+// throttle on the same bucket.
+func updateBucketBandwidthLimit(bucket string, q *madmin.BucketQuota) {
+	if q == nil || q.Rate == 0 {
+		globalBucketMonitor.DeleteBucketThrottle(bucket, "")
+		return
+	}
+	globalBucketMonitor.SetBandwidthLimit(bucket, "", int64(q.Rate))
+}
+
 func (sys *BucketQuotaSys) enforceQuotaHard(ctx context.Context, bucket string, size int64) error {
 	if size < 0 {
 		return nil
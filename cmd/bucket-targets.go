@@ -394,7 +394,14 @@ func (sys *BucketTargetSys) SetTarget(ctx context.Context, bucket string, tgt *m
 		}
 		newtgts[idx] = t
 	}
-	if !found && !update {
+	if !found {
+		if update {
+			// Caller asked to update an existing target but no target with
+			// this ARN is configured on this bucket -- fail loudly instead
+			// of silently discarding the new credentials, which would
+			// otherwise look like a successful credential rotation.
+			return BucketRemoteTargetNotFound{Bucket: tgt.TargetBucket}
+		}
 		newtgts = append(newtgts, *tgt)
 	}
 
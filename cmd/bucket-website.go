@@ -0,0 +1,148 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/pkg/v3/policy"
+)
+
+const bucketWebsiteConfigFile = "website.json"
+
+var (
+	errWebsiteConfigInvalidIndexDocument = errors.New("website configuration requires a non-empty index document")
+	errWebsiteConfigInvalidErrorDocument = errors.New("website error document must not contain a path separator")
+)
+
+// BucketWebsiteConfig holds the static website hosting configuration for a
+// bucket. This is a MinIO-specific, admin-managed alternative to the S3
+// PutBucketWebsite API (which MinIO does not implement, since it is tied to
+// AWS' ACL permission model); access to the bucket and to IndexDocument and
+// ErrorDocument is still governed entirely by the bucket's normal policy, so
+// enabling this only adds directory-index and custom-error-page convenience
+// on top of whatever access anonymous or authenticated callers already have.
+type BucketWebsiteConfig struct {
+	Enabled       bool   `json:"enabled"`
+	IndexDocument string `json:"indexDocument"`
+	ErrorDocument string `json:"errorDocument"`
+}
+
+// Validate checks that the website configuration is well-formed.
+func (cfg BucketWebsiteConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.IndexDocument == "" {
+		return errWebsiteConfigInvalidIndexDocument
+	}
+	if strings.Contains(cfg.IndexDocument, slashSeparator) {
+		return errWebsiteConfigInvalidIndexDocument
+	}
+	if cfg.ErrorDocument != "" && strings.Contains(cfg.ErrorDocument, slashSeparator) {
+		return errWebsiteConfigInvalidErrorDocument
+	}
+	return nil
+}
+
+func bucketWebsiteConfigPath(bucket string) string {
+	return path.Join(bucketMetaPrefix, bucket, bucketWebsiteConfigFile)
+}
+
+// saveBucketWebsiteConfig persists the website configuration for bucket.
+func saveBucketWebsiteConfig(ctx context.Context, objAPI ObjectLayer, bucket string, cfg BucketWebsiteConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, bucketWebsiteConfigPath(bucket), data)
+}
+
+// getBucketWebsiteConfig returns the website configuration for bucket.
+func getBucketWebsiteConfig(ctx context.Context, objAPI ObjectLayer, bucket string) (BucketWebsiteConfig, error) {
+	data, err := readConfig(ctx, objAPI, bucketWebsiteConfigPath(bucket))
+	if err != nil {
+		return BucketWebsiteConfig{}, err
+	}
+	var cfg BucketWebsiteConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return BucketWebsiteConfig{}, err
+	}
+	return cfg, nil
+}
+
+// deleteBucketWebsiteConfig removes the website configuration for bucket.
+func deleteBucketWebsiteConfig(ctx context.Context, objAPI ObjectLayer, bucket string) error {
+	err := deleteConfig(ctx, objAPI, bucketWebsiteConfigPath(bucket))
+	if errors.Is(err, errConfigNotFound) {
+		return nil
+	}
+	return err
+}
+
+// serveBucketWebsiteErrorDocument serves the bucket's configured error
+// document, if any, in place of the default NoSuchKey error response. It
+// reports whether it wrote a response; callers must fall back to the
+// default error response when it returns false. Access to the error
+// document itself is still subject to the bucket's normal policy, so this
+// never serves content that anonymous access would not already be allowed
+// to GET directly.
+func serveBucketWebsiteErrorDocument(ctx context.Context, objAPI ObjectLayer, bucket string, w http.ResponseWriter, r *http.Request) bool {
+	cfg, err := getBucketWebsiteConfig(ctx, objAPI, bucket)
+	if err != nil || !cfg.Enabled || cfg.ErrorDocument == "" {
+		return false
+	}
+
+	if !globalPolicySys.IsAllowed(policy.BucketPolicyArgs{
+		Action:          policy.GetObjectAction,
+		BucketName:      bucket,
+		ConditionValues: getConditionValues(r, "", auth.AnonymousCredentials),
+		IsOwner:         false,
+		ObjectName:      cfg.ErrorDocument,
+	}) {
+		return false
+	}
+
+	opts, err := getOpts(ctx, r, bucket, cfg.ErrorDocument)
+	if err != nil {
+		return false
+	}
+
+	gr, err := objAPI.GetObjectNInfo(ctx, bucket, cfg.ErrorDocument, nil, r.Header, opts)
+	if err != nil {
+		return false
+	}
+	defer gr.Close()
+
+	if err = setObjectHeaders(ctx, w, gr.ObjInfo, nil, opts); err != nil {
+		return false
+	}
+	w.WriteHeader(http.StatusNotFound)
+	io.Copy(w, gr)
+	return true
+}
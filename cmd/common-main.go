@@ -381,6 +381,10 @@ func buildServerCtxt(ctx *cli.Context, ctxt *serverCtxt) (err error) {
 		ctxt.CrossDomainXML = string(buf)
 	}
 
+	// Fetch IAM bootstrap file path, validated lazily once IAM is
+	// initialized since applying it requires a working object layer.
+	ctxt.IAMBootstrapFile = ctx.String("iam-bootstrap")
+
 	// Check "no-compat" flag from command line argument.
 	ctxt.StrictS3Compat = !(ctx.IsSet("no-compat") || ctx.GlobalIsSet("no-compat"))
 
@@ -432,7 +436,9 @@ func buildServerCtxt(ctx *cli.Context, ctxt *serverCtxt) (err error) {
 	ctxt.SendBufSize = ctx.Int("send-buf-size")
 	ctxt.RecvBufSize = ctx.Int("recv-buf-size")
 	ctxt.IdleTimeout = ctx.Duration("idle-timeout")
-	ctxt.UserTimeout = ctx.Duration("conn-user-timeout")
+	ctxt.ReadHeaderTimeout = ctx.Duration("read-header-timeout")
+	ctxt.ReadTimeout = ctx.Duration("read-timeout")
+	ctxt.WriteTimeout = ctx.Duration("write-timeout")
 
 	if conf := ctx.String("config"); len(conf) > 0 {
 		err = mergeServerCtxtFromConfigFile(conf, ctxt)
@@ -521,6 +527,10 @@ func handleCommonArgs(ctxt serverCtxt) {
 	globalCertsCADir = &ConfigDir{path: filepath.Join(globalCertsDir.Get(), certsCADir)}
 
 	logger.FatalIf(mkdirAllIgnorePerm(globalCertsCADir.Get()), "Unable to create certs CA directory at %s", globalCertsCADir.Get())
+
+	// Load any drives this node previously chose to stop connecting to.
+	// This must happen after globalConfigDir is finalized above.
+	globalIgnoredDisks.init()
 }
 
 func runDNSCache(ctx *cli.Context) {
@@ -833,6 +843,20 @@ func serverHandleEnvVars() {
 	}
 
 	globalEnableSyncBoot = env.Get("MINIO_SYNC_BOOT", config.EnableOff) == config.EnableOn
+
+	// Anonymous telemetry collection is strictly opt-in.
+	globalTelemetryEnabled = env.Get("MINIO_ANONYMOUS_TELEMETRY", config.EnableOff) == config.EnableOn
+	globalTelemetryEndpoint = env.Get("MINIO_ANONYMOUS_TELEMETRY_ENDPOINT", "")
+	if freq := env.Get("MINIO_ANONYMOUS_TELEMETRY_FREQUENCY", ""); freq != "" {
+		dur, err := time.ParseDuration(freq)
+		if err != nil {
+			logger.Fatal(err, "Invalid MINIO_ANONYMOUS_TELEMETRY_FREQUENCY value in environment variable")
+		}
+		globalTelemetryFrequency = dur
+	}
+
+	// Debug mode for signature mismatches, strictly opt-in.
+	globalSignatureDebugMode = env.Get("MINIO_SIGNATURE_DEBUG", config.EnableOff) == config.EnableOn
 }
 
 func loadRootCredentials() {
@@ -874,17 +898,18 @@ func loadRootCredentials() {
 				config.EnvRootUser, config.EnvRootPassword)
 			logger.Info(color.RedBold(msg))
 		}
-		globalActiveCred = cred
+		setGlobalActiveCred(cred)
 		globalCredViaEnv = true
 	} else {
-		globalActiveCred = auth.DefaultCredentials
+		setGlobalActiveCred(auth.DefaultCredentials)
 	}
 
 	var err error
-	globalNodeAuthToken, err = authenticateNode(globalActiveCred.AccessKey, globalActiveCred.SecretKey)
+	globalNodeAuthToken, err = authenticateNode(globalActiveCred().AccessKey, globalActiveCred().SecretKey)
 	if err != nil {
 		logger.Fatal(err, "Unable to generate internode credentials")
 	}
+	nodeAuthTokenMintedAt = UTCNow()
 }
 
 // Initialize KMS global variable after valiadating and loading the configuration.
@@ -947,15 +972,58 @@ func getTLSConfig() (x509Certs []*x509.Certificate, manager *certs.Manager, secu
 	// Therefore, we read all filenames in the cert directory and check
 	// for each directory whether it contains a public.crt and private.key.
 	// If so, we try to add it to certificate manager.
+	seen := make(map[string]bool)
+	scanDomainCertificates(manager, seen)
+	secureConn = true
+
+	// Certs might be symlinks, reload them every 10 seconds.
+	manager.UpdateReloadDuration(10 * time.Second)
+
+	// syscall.SIGHUP to reload the certs.
+	manager.ReloadOnSignal(syscall.SIGHUP)
+
+	// A domain's public.crt/private.key pair added to the certs directory
+	// after startup (e.g. provisioning a new domain) would otherwise only
+	// be picked up on the next restart, since the scan above only runs
+	// once. Keep rescanning for newly added per-domain directories so such
+	// additions - including a freshly issued Let's Encrypt certificate for
+	// a brand new domain - don't require one.
+	go func() {
+		ticker := time.NewTicker(domainCertsRescanInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-GlobalContext.Done():
+				return
+			case <-ticker.C:
+				scanDomainCertificates(manager, seen)
+			}
+		}
+	}()
+
+	return x509Certs, manager, secureConn, nil
+}
+
+// domainCertsRescanInterval is how often the certs directory is rescanned
+// for newly added per-domain certificate directories.
+const domainCertsRescanInterval = 1 * time.Minute
+
+// scanDomainCertificates walks globalCertsDir for per-domain sub-directories
+// containing a public.crt/private.key pair and registers any not already
+// present in seen with manager. seen is updated in place with the certFile
+// path of every pair successfully added, so repeated calls only register
+// newly discovered pairs - manager.AddCertificate is not safe to call twice
+// for the same pair since it would start a duplicate file watcher.
+func scanDomainCertificates(manager *certs.Manager, seen map[string]bool) {
 	root, err := Open(globalCertsDir.Get())
 	if err != nil {
-		return nil, nil, false, err
+		return
 	}
 	defer root.Close()
 
 	files, err := root.Readdir(-1)
 	if err != nil {
-		return nil, nil, false, err
+		return
 	}
 	for _, file := range files {
 		// Ignore all
@@ -983,20 +1051,16 @@ func getTLSConfig() (x509Certs []*x509.Certificate, manager *certs.Manager, secu
 		if !isFile(certFile) || !isFile(keyFile) {
 			continue
 		}
+		if seen[certFile] {
+			continue
+		}
 		if err = manager.AddCertificate(certFile, keyFile); err != nil {
 			err = fmt.Errorf("Unable to load TLS certificate '%s,%s': %w", certFile, keyFile, err)
 			bootLogIf(GlobalContext, err, logger.ErrorKind)
+			continue
 		}
+		seen[certFile] = true
 	}
-	secureConn = true
-
-	// Certs might be symlinks, reload them every 10 seconds.
-	manager.UpdateReloadDuration(10 * time.Second)
-
-	// syscall.SIGHUP to reload the certs.
-	manager.ReloadOnSignal(syscall.SIGHUP)
-
-	return x509Certs, manager, secureConn, nil
 }
 
 // contextCanceled returns whether a context is canceled.
@@ -736,7 +736,7 @@ func autoGenerateRootCredentials() {
 	if GlobalKMS == nil {
 		return
 	}
-	if globalAPIConfig.permitRootAccess() || !globalActiveCred.Equal(auth.DefaultCredentials) {
+	if globalAPIConfig.permitRootAccess() || !globalActiveCred().Equal(auth.DefaultCredentials) {
 		return
 	}
 
@@ -764,10 +764,10 @@ func autoGenerateRootCredentials() {
 	}
 
 	logger.Info("Automatically generated root access key and secret key with the KMS")
-	globalActiveCred = auth.Credentials{
+	setGlobalActiveCred(auth.Credentials{
 		AccessKey: accessKey,
 		SecretKey: secretKey,
-	}
+	})
 }
 
 // applyDynamicConfig will apply dynamic config values.
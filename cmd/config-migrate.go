@@ -112,7 +112,7 @@ func readConfigWithoutMigrate(ctx context.Context, objAPI ObjectLayer) (config.C
 	if !globalCredViaEnv && cfg.Credential.IsValid() {
 		// Preserve older credential if we do not have
 		// root credentials set via environment variable.
-		globalActiveCred = cfg.Credential
+		setGlobalActiveCred(cfg.Credential)
 	}
 
 	// Init compression config. For future migration, Compression config needs to be copied over from previous version.
@@ -113,10 +113,22 @@ func readServerConfigHistory(ctx context.Context, objAPI ObjectLayer, uuidKV str
 	return decryptData(data, historyFile)
 }
 
-func saveServerConfigHistory(ctx context.Context, objAPI ObjectLayer, kv []byte) error {
+// configHistoryActorKey is the comment key used to record the access key of
+// the admin credential that produced a given config history entry. Comment
+// lines are silently skipped by Config.ReadConfig, so this does not affect
+// how a history entry is later restored.
+const configHistoryActorKey = "actor"
+
+func saveServerConfigHistory(ctx context.Context, objAPI ObjectLayer, kv []byte, actor string) error {
 	uuidKV := mustGetUUID() + kvPrefix
 	historyFile := pathJoin(minioConfigHistoryPrefix, uuidKV)
 
+	if actor != "" {
+		actorComment := config.KvComment + config.KvSpaceSeparator + configHistoryActorKey +
+			config.KvSpaceSeparator + actor + "\n"
+		kv = append([]byte(actorComment), kv...)
+	}
+
 	if GlobalKMS != nil {
 		var err error
 		kv, err = config.EncryptBytes(GlobalKMS, kv, kms.Context{
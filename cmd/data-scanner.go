@@ -368,7 +368,16 @@ func scanDataFolder(ctx context.Context, disks []StorageAPI, drive *xlStorage, c
 	default:
 	}
 	root := dataUsageEntry{}
-	folder := cachedFolder{name: cache.Info.Name, objectHealProbDiv: 1}
+	// sizeBeforeScan is the bucket's total size as of the end of the cycle
+	// that just completed (i.e. before the scan below runs). Comparing it
+	// against cache.Info.PrevSize, which was recorded the same way one
+	// cycle earlier, gives an estimate of how much this bucket has
+	// changed recently, without waiting on the scan that is about to run.
+	sizeBeforeScan := int64(0)
+	if r := cache.sizeRecursive(cache.Info.Name); r != nil {
+		sizeBeforeScan = r.Size
+	}
+	folder := cachedFolder{name: cache.Info.Name, objectHealProbDiv: healProbDivForChangeRate(cache.Info.PrevSize, sizeBeforeScan)}
 	err := s.scanFolder(ctx, folder, &root)
 	if err != nil {
 		// No useful information...
@@ -377,9 +386,39 @@ func scanDataFolder(ctx context.Context, disks []StorageAPI, drive *xlStorage, c
 	s.newCache.forceCompact(dataScannerCompactAtChildren)
 	s.newCache.Info.LastUpdate = UTCNow()
 	s.newCache.Info.NextCycle = cache.Info.NextCycle
+	s.newCache.Info.PrevSize = sizeBeforeScan
 	return s.newCache, nil
 }
 
+// maxHealProbDiv bounds how much more frequently a busy bucket can be
+// selected for heal checks relative to an idle one. It is kept well below
+// healObjectSelectProb so that even the busiest bucket still gets a heal
+// check only periodically rather than on every single cycle.
+const maxHealProbDiv = 8
+
+// healProbDivForChangeRate returns the objectHealProbDiv to use for a
+// bucket, given its total size as recorded at the end of the previous two
+// completed cycles. Buckets whose size changed the most relative to their
+// own size get a higher divisor, which folderScanner.scanFolder uses to
+// shrink the effective cycle count in its heal-selection probability,
+// scanning such buckets for heal more often than mostly static ones.
+func healProbDivForChangeRate(prevSize, lastSize int64) uint32 {
+	if prevSize <= 0 {
+		// Nothing to compare against yet, e.g. the bucket's first cycle.
+		return 1
+	}
+	delta := lastSize - prevSize
+	if delta < 0 {
+		delta = -delta
+	}
+	pctChange := delta * 100 / prevSize
+	div := 1 + pctChange/20
+	if div > maxHealProbDiv {
+		div = maxHealProbDiv
+	}
+	return uint32(div)
+}
+
 // sendUpdate() should be called on a regular basis when the newCache contains more recent total than previously.
 // May or may not send an update upstream.
 func (f *folderScanner) sendUpdate() {
@@ -1366,6 +1405,95 @@ func applyExpiryOnNonTransitionedObjects(ctx context.Context, objLayer ObjectLay
 	return true
 }
 
+// expiryBatchable reports whether an expiry task is a plain current/version
+// delete that is safe to group with other tasks of the same bucket and
+// action into a single bulk DeleteObjects call. Transitioned objects and the
+// DeleteAllVersions family need their own per-object handling, so they are
+// excluded here.
+func expiryBatchable(t expiryTask) bool {
+	if t.objInfo.TransitionedObject.Status != "" {
+		return false
+	}
+	switch t.event.Action {
+	case lifecycle.DeleteAction, lifecycle.DeleteVersionAction,
+		lifecycle.DeleteRestoredAction, lifecycle.DeleteRestoredVersionAction:
+		return true
+	}
+	return false
+}
+
+// expireObjectBatch expires a batch of non-transitioned objects/versions,
+// all from the same bucket and sharing the same lifecycle action, using a
+// single bulk DeleteObjects call instead of one DeleteObject call (and thus
+// one StorageAPI round-trip per disk) for every object.
+func expireObjectBatch(ctx context.Context, objLayer ObjectLayer, bucket string, tasks []expiryTask, lcEvent lifecycle.Event, src lcEventSrc) {
+	if len(tasks) == 0 {
+		return
+	}
+	if len(tasks) == 1 {
+		applyExpiryOnNonTransitionedObjects(ctx, objLayer, tasks[0].objInfo, lcEvent, src)
+		return
+	}
+
+	toDel := make([]ObjectToDelete, len(tasks))
+	for i, t := range tasks {
+		toDel[i].ObjectV.ObjectName = encodeDirObject(t.objInfo.Name)
+		if lcEvent.Action.DeleteVersioned() {
+			toDel[i].ObjectV.VersionID = t.objInfo.VersionID
+		}
+	}
+
+	vc, _ := globalBucketVersioningSys.Get(bucket)
+	dobjs, errs := objLayer.DeleteObjects(ctx, bucket, toDel, ObjectOptions{
+		PrefixEnabledFn:  vc.PrefixEnabled,
+		VersionSuspended: vc.Suspended(),
+	})
+
+	timeILM := globalScannerMetrics.timeILM(lcEvent.Action)
+	var deleted uint64
+	for i, t := range tasks {
+		obj := t.objInfo
+		traceFn := globalLifecycleSys.trace(obj)
+
+		if err := errs[i]; err != nil {
+			if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+				traceFn(ILMExpiry, nil, nil)
+				continue
+			}
+			err := fmt.Errorf("DeleteObject(%s, %s): %w", obj.Bucket, obj.Name, err)
+			ilmLogOnceIf(ctx, err, "non-transition-expiry"+obj.Name)
+			traceFn(ILMExpiry, nil, err)
+			continue
+		}
+
+		dobj := dobjs[i]
+		tags := newLifecycleAuditEvent(src, lcEvent).Tags()
+		tags["version-id"] = dobj.VersionID
+
+		// Send audit for the lifecycle delete operation
+		auditLogLifecycle(ctx, ObjectInfo{Bucket: bucket, Name: dobj.ObjectName, VersionID: dobj.VersionID}, ILMExpiry, tags, traceFn)
+
+		eventName := event.ObjectRemovedDelete
+		if obj.DeleteMarker {
+			eventName = event.ObjectRemovedDeleteMarkerCreated
+		}
+		sendEvent(eventArgs{
+			EventName:  eventName,
+			BucketName: bucket,
+			Object: ObjectInfo{
+				Name:      dobj.ObjectName,
+				VersionID: dobj.VersionID,
+			},
+			UserAgent: "Internal: [ILM-Expiry]",
+			Host:      globalLocalNodeName,
+		})
+		deleted++
+	}
+	if deleted > 0 {
+		timeILM(deleted)
+	}
+}
+
 // Apply object, object version, restored object or restored object version action on the given object
 func applyExpiryRule(event lifecycle.Event, src lcEventSrc, obj ObjectInfo) bool {
 	globalExpiryState.enqueueByDays(obj, event, src)
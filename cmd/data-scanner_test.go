@@ -237,3 +237,29 @@ func TestEvalActionFromLifecycle(t *testing.T) {
 		})
 	}
 }
+
+func TestHealProbDivForChangeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		prevSize int64
+		lastSize int64
+		want     uint32
+	}{
+		{name: "first cycle", prevSize: 0, lastSize: 1 << 20, want: 1},
+		{name: "unchanged", prevSize: 1 << 20, lastSize: 1 << 20, want: 1},
+		{name: "shrunk by half", prevSize: 1 << 20, lastSize: 1 << 19, want: 3},
+		{name: "doubled", prevSize: 1 << 20, lastSize: 2 << 20, want: 6},
+		{name: "tiny change", prevSize: 1 << 20, lastSize: (1 << 20) + 1, want: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := healProbDivForChangeRate(test.prevSize, test.lastSize)
+			if got != test.want {
+				t.Fatalf("Expected %v but got %v", test.want, got)
+			}
+			if got < 1 || got > maxHealProbDiv {
+				t.Fatalf("objectHealProbDiv must stay within [1, %d], got %v", maxHealProbDiv, got)
+			}
+		})
+	}
+}
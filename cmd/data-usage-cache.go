@@ -262,6 +262,13 @@ type dataUsageCacheInfo struct {
 	// should skip healing the disk
 	SkipHealing bool
 
+	// PrevSize is the total size of the bucket as of the end of the
+	// previous completed cycle. It is compared against the size recorded
+	// at the end of the cycle that just finished to estimate how much the
+	// bucket has changed recently, which in turn is used to scan busier
+	// buckets for heal more frequently than mostly idle ones.
+	PrevSize int64
+
 	// Active lifecycle, if any on the bucket
 	lifeCycle *lifecycle.Lifecycle `msg:"-"`
 
@@ -67,6 +67,11 @@ type BucketUsageInfo struct {
 	ReplicaSize             uint64                           `json:"objectReplicaTotalSize"`
 	ReplicaCount            uint64                           `json:"objectReplicaCount"`
 	ReplicationInfo         map[string]BucketTargetUsageInfo `json:"objectsReplicationInfo"`
+
+	// Tags is the bucket's current tag set, attached here so that usage
+	// reports can be attributed to a team/project without joining against
+	// bucket metadata separately.
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
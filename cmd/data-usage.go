@@ -161,5 +161,18 @@ func loadDataUsageFromBackend(ctx context.Context, objAPI ObjectLayer) (DataUsag
 			}
 		}
 	}
+
+	// Attach the bucket's current tags so that usage reports and metrics
+	// derived from them can be attributed to a team/project, without
+	// requiring a re-scan whenever tags change.
+	for bucket, bui := range dataUsageInfo.BucketsUsage {
+		t, _, err := globalBucketMetadataSys.GetTaggingConfig(bucket)
+		if err != nil {
+			continue
+		}
+		bui.Tags = t.ToMap()
+		dataUsageInfo.BucketsUsage[bucket] = bui
+	}
+
 	return dataUsageInfo, nil
 }
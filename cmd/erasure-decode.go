@@ -124,7 +124,7 @@ func (p *parallelReader) canDecode(buf [][]byte) bool {
 }
 
 // Read reads from readers in parallel. Returns p.dataBlocks number of bufs.
-func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
+func (p *parallelReader) Read(ctx context.Context, dst [][]byte) ([][]byte, error) {
 	newBuf := dst
 	if len(dst) != len(p.readers) {
 		newBuf = make([][]byte, len(p.readers))
@@ -189,14 +189,22 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 			// For the last shard, the shardsize might be less than previous shard sizes.
 			// Hence the following statement ensures that the buffer size is reset to the right size.
 			p.buf[bufIdx] = p.buf[bufIdx][:p.shardSize]
-			n, err := rr.ReadAt(p.buf[bufIdx], p.offset)
+			// Bound each shard read with a deadline so a single disk stuck
+			// on a blocking syscall (e.g. a hung NFS-backed mount) cannot
+			// stall the whole request; a disk that misses its deadline is
+			// treated the same as errDiskNotFound below, which drops it for
+			// the remainder of this read and immediately retries with the
+			// next disk to satisfy quorum via parity.
+			n, err := xioutil.WithDeadline[int](ctx, globalDriveConfig.GetMaxTimeout(), func(ctx context.Context) (int, error) {
+				return rr.ReadAt(p.buf[bufIdx], p.offset)
+			})
 			if err != nil {
 				switch {
 				case errors.Is(err, errFileNotFound):
 					atomic.StoreInt32(&missingPartsHeal, 1)
 				case errors.Is(err, errFileCorrupt):
 					atomic.StoreInt32(&bitrotHeal, 1)
-				case errors.Is(err, errDiskNotFound):
+				case errors.Is(err, errDiskNotFound), errors.Is(err, context.DeadlineExceeded):
 					atomic.AddInt32(&disksNotFound, 1)
 				}
 
@@ -280,7 +288,7 @@ func (e Erasure) Decode(ctx context.Context, writer io.Writer, readers []io.Read
 		}
 
 		var err error
-		bufs, err = reader.Read(bufs)
+		bufs, err = reader.Read(ctx, bufs)
 		if len(bufs) > 0 {
 			// Set only if there are be enough data for reconstruction.
 			// and only for expected errors, also set once.
@@ -334,7 +342,7 @@ func (e Erasure) Heal(ctx context.Context, writers []io.Writer, readers []io.Rea
 	var bufs [][]byte
 	for block := startBlock; block < endBlock; block++ {
 		var err error
-		bufs, err = reader.Read(bufs)
+		bufs, err = reader.Read(ctx, bufs)
 		if len(bufs) > 0 {
 			if errors.Is(err, errFileNotFound) || errors.Is(err, errFileCorrupt) {
 				if derr == nil {
@@ -277,6 +277,44 @@ func objectErrToDriveState(reason error) string {
 	}
 }
 
+// healReasonSummary tallies the per-drive reasons a heal was needed (as
+// classified by shouldHealObjectOnDisk) into a short, human-readable string
+// for HealResultItem.Detail, e.g. "2 drive(s) missing xl.meta, 1 drive(s)
+// with corrupt data" - so heal status/logs surface *why* an object needed
+// healing, not just that it did.
+func healReasonSummary(reasons []error) string {
+	var missing, corrupt, outdated, other int
+	for _, reason := range reasons {
+		switch {
+		case reason == nil:
+		case IsErr(reason, errFileNotFound, errFileVersionNotFound, errVolumeNotFound, errPartMissing):
+			missing++
+		case IsErr(reason, errFileCorrupt, errPartCorrupt):
+			corrupt++
+		case IsErr(reason, errOutdatedXLMeta, errLegacyXLMeta):
+			outdated++
+		default:
+			other++
+		}
+	}
+
+	var parts []string
+	if missing > 0 {
+		parts = append(parts, fmt.Sprintf("%d drive(s) missing xl.meta", missing))
+	}
+	if corrupt > 0 {
+		parts = append(parts, fmt.Sprintf("%d drive(s) with corrupt data", corrupt))
+	}
+	if outdated > 0 {
+		parts = append(parts, fmt.Sprintf("%d drive(s) with outdated metadata", outdated))
+	}
+	if other > 0 {
+		parts = append(parts, fmt.Sprintf("%d drive(s) with other errors", other))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 // Heals an object by re-writing corrupt/missing erasure blocks.
 func (er *erasureObjects) healObject(ctx context.Context, bucket string, object string, versionID string, opts madmin.HealOpts) (result madmin.HealResultItem, err error) {
 	dryRun := opts.DryRun
@@ -395,11 +433,13 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 	// to be healed.
 	outDatedDisks := make([]StorageAPI, len(storageDisks))
 	disksToHealCount := 0
+	healReasons := make([]error, 0, len(availableDisks))
 	for i := range availableDisks {
 		yes, reason := shouldHealObjectOnDisk(errs[i], dataErrsByDisk[i], partsMetadata[i], latestMeta)
 		if yes {
 			outDatedDisks[i] = storageDisks[i]
 			disksToHealCount++
+			healReasons = append(healReasons, reason)
 		}
 
 		driveState := objectErrToDriveState(reason)
@@ -416,6 +456,14 @@ func (er *erasureObjects) healObject(ctx context.Context, bucket string, object
 		})
 	}
 
+	// Record why this object needed healing (and on how many drives) in
+	// Detail so heal status output and logs can distinguish a single
+	// stale replica from widespread corruption, instead of only reporting
+	// a reason once healing has failed outright (see below).
+	if disksToHealCount > 0 {
+		result.Detail = fmt.Sprintf("%d/%d drive(s) need healing: %s", disksToHealCount, len(availableDisks), healReasonSummary(healReasons))
+	}
+
 	if isAllNotFound(errs) {
 		// File is fully gone, fileInfo is empty.
 		err := errFileNotFound
@@ -193,6 +193,16 @@ func hashOrder(key string, cardinality int) []int {
 
 // Reads all `xl.meta` metadata as a FileInfo slice.
 // Returns error slice indicating the failed metadata reads.
+//
+// This intentionally waits for every disk to respond rather than returning
+// as soon as a read-quorum number of consistent copies have been seen: the
+// returned metadataArray/errs are slot-aligned with disks (index i here is
+// disk[i]), and callers such as objectQuorumFromMeta, listOnlineDisks and
+// isAllNotFound all reduce over the full, disk-indexed slice to decide which
+// disks are online, stale or dangling. Returning early would leave the slots
+// for the disks still in flight empty, which those callers cannot tell apart
+// from a disk that legitimately returned no metadata - so a slow disk would
+// be mistaken for a missing one and could trigger an unwarranted heal.
 func readAllFileInfo(ctx context.Context, disks []StorageAPI, origbucket string, bucket, object, versionID string, readData, healing bool) ([]FileInfo, []error) {
 	metadataArray := make([]FileInfo, len(disks))
 
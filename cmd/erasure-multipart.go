@@ -389,10 +389,22 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 		}
 
 		obj, err := er.getObjectInfo(ctx, bucket, object, opts)
-		if err == nil && opts.CheckPrecondFn(obj) {
-			return nil, PreConditionFailed{}
-		}
-		if err != nil && !isErrVersionNotFound(err) && !isErrObjectNotFound(err) && !isErrReadQuorum(err) {
+		switch {
+		case err == nil:
+			if opts.CheckPrecondFn(obj) {
+				return nil, PreConditionFailed{}
+			}
+		case isErrVersionNotFound(err), isErrObjectNotFound(err):
+			// The object/version does not exist yet - evaluate preconditions
+			// against an absent object so that If-Match still fails (there is
+			// nothing to match) while If-None-Match still succeeds, instead
+			// of skipping the precondition check entirely and letting every
+			// conditional create race through unconditionally.
+			if opts.CheckPrecondFn(ObjectInfo{}) {
+				return nil, PreConditionFailed{}
+			}
+		case isErrReadQuorum(err):
+		default:
 			return nil, err
 		}
 	}
@@ -533,18 +545,21 @@ func (er erasureObjects) renamePart(ctx context.Context, disks []StorageAPI, src
 		dstEntry + ".meta",
 	}
 
-	// cleanup existing paths first across all drives.
-	er.cleanupMultipartPath(ctx, paths...)
-
 	g := errgroup.WithNErrs(len(disks))
 
-	// Rename file on all underlying storage disks.
+	// Clean up stale paths left behind by a previous attempt and rename,
+	// per disk, instead of a cleanup pass across all drives followed by a
+	// separate rename pass across all drives. Each disk still cleans up
+	// before renaming its own copy, but disks are no longer held back by a
+	// barrier waiting on the slowest disk's cleanup - this removes one
+	// synchronization round-trip from the hot path of every part upload.
 	for index := range disks {
 		index := index
 		g.Go(func() error {
 			if disks[index] == nil {
 				return errDiskNotFound
 			}
+			_ = disks[index].DeleteBulk(ctx, dstBucket, paths...)
 			return disks[index].RenamePart(ctx, srcBucket, srcEntry, dstBucket, dstEntry, optsMeta)
 		}, index)
 	}
@@ -1083,10 +1098,22 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 		}
 
 		obj, err := er.getObjectInfo(ctx, bucket, object, opts)
-		if err == nil && opts.CheckPrecondFn(obj) {
-			return ObjectInfo{}, PreConditionFailed{}
-		}
-		if err != nil && !isErrVersionNotFound(err) && !isErrObjectNotFound(err) && !isErrReadQuorum(err) {
+		switch {
+		case err == nil:
+			if opts.CheckPrecondFn(obj) {
+				return ObjectInfo{}, PreConditionFailed{}
+			}
+		case isErrVersionNotFound(err), isErrObjectNotFound(err):
+			// The object/version does not exist yet - evaluate preconditions
+			// against an absent object so that If-Match still fails (there is
+			// nothing to match) while If-None-Match still succeeds, instead
+			// of skipping the precondition check entirely and letting every
+			// conditional create race through unconditionally.
+			if opts.CheckPrecondFn(ObjectInfo{}) {
+				return ObjectInfo{}, PreConditionFailed{}
+			}
+		case isErrReadQuorum(err):
+		default:
 			return ObjectInfo{}, err
 		}
 	}
@@ -1267,10 +1267,22 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		}
 
 		obj, err := er.getObjectInfo(ctx, bucket, object, opts)
-		if err == nil && opts.CheckPrecondFn(obj) {
-			return objInfo, PreConditionFailed{}
-		}
-		if err != nil && !isErrVersionNotFound(err) && !isErrObjectNotFound(err) && !isErrReadQuorum(err) {
+		switch {
+		case err == nil:
+			if opts.CheckPrecondFn(obj) {
+				return objInfo, PreConditionFailed{}
+			}
+		case isErrVersionNotFound(err), isErrObjectNotFound(err):
+			// The object/version does not exist yet - evaluate preconditions
+			// against an absent object so that If-Match still fails (there is
+			// nothing to match) while If-None-Match still succeeds, instead
+			// of skipping the precondition check entirely and letting every
+			// conditional create race through unconditionally.
+			if opts.CheckPrecondFn(ObjectInfo{}) {
+				return objInfo, PreConditionFailed{}
+			}
+		case isErrReadQuorum(err):
+		default:
 			return objInfo, err
 		}
 	}
@@ -1330,6 +1342,18 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		writeQuorum++
 	}
 
+	// When the object's size is known upfront, fail fast with StorageFull if
+	// this erasure set does not have enough aggregate free space to hold it
+	// (accounting for parity overhead), instead of discovering this midway
+	// through the write.
+	if size := data.Size(); size >= 0 {
+		if ok, serr := hasSpaceFor(getDiskInfos(ctx, storageDisks...), size); serr != nil {
+			storageLogIf(ctx, serr)
+		} else if !ok {
+			return ObjectInfo{}, toObjectErr(errDiskFull, bucket, object)
+		}
+	}
+
 	// Initialize parts metadata
 	partsMetadata := make([]FileInfo, len(storageDisks))
 
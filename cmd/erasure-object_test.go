@@ -29,6 +29,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dustin/go-humanize"
@@ -1215,3 +1216,84 @@ func TestGetObjectWithOutdatedDisks(t *testing.T) {
 		}
 	}
 }
+
+// TestPutObjectTagsNoDataRewrite asserts that updating an object's tags does
+// not touch the object's data shards at all: it removes every "part.*" data
+// file from disk first, then expects PutObjectTags to still succeed since it
+// only needs to read and rewrite xl.meta.
+func TestPutObjectTagsNoDataRewrite(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const numberOfDisks = 4
+
+	obj, fsDirs, err := prepareErasure(ctx, numberOfDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	object := "object"
+
+	if err = obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Large enough to be stored as on-disk part data rather than inlined
+	// into xl.meta, so that removing "part.*" files below actually removes
+	// the object's data.
+	data := bytes.Repeat([]byte{'a'}, smallFileThreshold*numberOfDisks)
+	if _, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove every data shard from every disk, leaving only xl.meta behind.
+	// The "part.*" files live in the object's data directory, one level
+	// below xl.meta.
+	removed := 0
+	for _, dir := range fsDirs {
+		objDir := filepath.Join(dir, bucket, object)
+		entries, err := os.ReadDir(objDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			parts, err := os.ReadDir(filepath.Join(objDir, entry.Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, part := range parts {
+				if strings.HasPrefix(part.Name(), "part.") {
+					if err = os.Remove(filepath.Join(objDir, entry.Name(), part.Name())); err != nil {
+						t.Fatal(err)
+					}
+					removed++
+				}
+			}
+		}
+	}
+	if removed == 0 {
+		t.Fatal("expected to find and remove at least one part data file")
+	}
+
+	// Updating tags must succeed without touching the (now missing) data.
+	if _, err = obj.PutObjectTags(ctx, bucket, object, "k1=v1", ObjectOptions{}); err != nil {
+		t.Fatalf("PutObjectTags should not require object data, but failed with %v", err)
+	}
+
+	// Confirm that the data really is gone, i.e. this test actually
+	// exercised the no-data-rewrite path and isn't vacuously true.
+	gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, ObjectOptions{})
+	if err == nil {
+		_, err = io.Copy(io.Discard, gr)
+		gr.Close()
+	}
+	if err == nil {
+		t.Fatal("expected reading object data to fail after removing its data shards")
+	}
+}
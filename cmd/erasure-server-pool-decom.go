@@ -300,6 +300,13 @@ func (p *poolMeta) Decommission(idx int, pi poolSpaceInfo) error {
 		return errDecommissionAlreadyRunning
 	}
 
+	// A pool that already finished decommissioning is done for good - it
+	// must be removed from the server command-line before it can be used
+	// again, it cannot simply be decommissioned a second time in place.
+	if p.Pools[idx].Decommission != nil && p.Pools[idx].Decommission.Complete {
+		return errDecommissionComplete
+	}
+
 	now := UTCNow()
 	p.Pools[idx].LastUpdate = now
 	p.Pools[idx].Decommission = &PoolDecommissionInfo{
@@ -2154,11 +2154,38 @@ func (z *erasureServerPools) DeleteBucket(ctx context.Context, bucket string, op
 	if err == nil {
 		// Purge the entire bucket metadata entirely.
 		z.deleteAll(context.Background(), minioMetaBucket, pathJoin(bucketMetaPrefix, bucket))
+
+		if opts.Force {
+			// Abort any pending multipart uploads left behind in this bucket,
+			// otherwise their parts remain orphaned under .minio.sys/multipart
+			// forever since they are keyed by a hash of bucket+object, not by
+			// bucket, and are therefore not cleaned up by the deleteAll above.
+			z.abortMultipartUploads(context.Background(), bucket)
+		}
 	}
 
 	return toObjectErr(err, bucket)
 }
 
+// abortMultipartUploads aborts all in-progress multipart uploads known for
+// bucket, best-effort. Used to avoid leaking multipart upload state when a
+// bucket is force deleted.
+func (z *erasureServerPools) abortMultipartUploads(ctx context.Context, bucket string) {
+	var uploads []MultipartInfo
+	z.mpCache.Range(func(_ string, mp MultipartInfo) bool {
+		if mp.Bucket == bucket {
+			uploads = append(uploads, mp)
+		}
+		return true
+	})
+
+	for _, mp := range uploads {
+		if err := z.AbortMultipartUpload(ctx, mp.Bucket, mp.Object, mp.UploadID, ObjectOptions{}); err != nil {
+			s3LogIf(ctx, fmt.Errorf("unable to abort multipart upload %s on deleted bucket %s: %w", mp.UploadID, bucket, err))
+		}
+	}
+}
+
 // deleteAll will rename bucket+prefix unconditionally across all disks to
 // minioMetaTmpDeletedBucket + unique uuid,
 // Note that set distribution is ignored so it should only be used in cases where
@@ -2262,8 +2289,23 @@ func (z *erasureServerPools) HealFormat(ctx context.Context, dryRun bool) (madmi
 }
 
 func (z *erasureServerPools) HealBucket(ctx context.Context, bucket string, opts madmin.HealOpts) (madmin.HealResultItem, error) {
-	// .metadata.bin healing is not needed here, it is automatically healed via read() call.
-	return z.s3Peer.HealBucket(ctx, bucket, opts)
+	result, err := z.s3Peer.HealBucket(ctx, bucket, opts)
+	if err != nil {
+		return result, err
+	}
+
+	// .metadata.bin is otherwise only healed opportunistically - by the
+	// periodic scanner, or on a read that happens to hit a stale copy - so
+	// an explicit HealBucket call verifies and repairs it against quorum
+	// right away instead of leaving the caller to wait for one of those.
+	// A missing .metadata.bin (e.g. a bucket created with no non-default
+	// configuration yet) is expected and not a heal failure.
+	metadataFile := path.Join(bucketMetaPrefix, bucket, bucketMetadataFile)
+	if _, herr := z.HealObject(ctx, minioMetaBucket, metadataFile, "", opts); herr != nil && !isErrObjectNotFound(herr) && !isErrVersionNotFound(herr) {
+		healingLogIf(ctx, fmt.Errorf("unable to heal bucket metadata for %s: %w", bucket, herr))
+	}
+
+	return result, nil
 }
 
 // Walk a bucket, optionally prefix recursively, until we have returned
@@ -112,6 +112,10 @@ func (s *erasureSets) getDiskMap() map[Endpoint]StorageAPI {
 // Initializes a new StorageAPI from the endpoint argument, returns
 // StorageAPI and also `format` which exists on the disk.
 func connectEndpoint(endpoint Endpoint) (StorageAPI, *formatErasureV3, error) {
+	if info, ok := globalIgnoredDisks.IsIgnored(endpoint.String()); ok {
+		return nil, nil, fmt.Errorf("drive %s is marked ignored since %s (%s); clear it via the admin API to reconnect", endpoint, info.Timestamp, info.Reason)
+	}
+
 	disk, err := newStorageAPI(endpoint, storageOpts{
 		cleanUp:     false,
 		healthCheck: false,
@@ -252,6 +256,22 @@ func (s *erasureSets) connectDisks(log bool) {
 					err = fmt.Errorf("Detected unexpected drive ordering refusing to use the drive: expecting %s, found %s, refusing to use the drive",
 						currentDisk.Endpoint(), disk.Endpoint())
 					printEndpointError(endpoint, err, false)
+					// Remember why this drive was refused so that it is not
+					// retried every connectDisks tick, and so that an admin
+					// inspecting the ignored-drives list can see precisely
+					// which drive lost out on a duplicated/drifted driveID
+					// without having to dig through server logs.
+					//
+					// This only records the refusal reached on a single
+					// connectDisks pass against whichever format.json this
+					// drive happens to present; it is not the periodic
+					// cross-disk consistency checker that walks every
+					// drive's format.json looking for drift, nor does it
+					// repair a missing/drifted copy from quorum once found -
+					// neither of those exists in this tree.
+					if mErr := globalIgnoredDisks.Mark(endpoint.String(), err.Error()); mErr != nil {
+						storageLogAlwaysIf(GlobalContext, fmt.Errorf("unable to persist ignored state for drive %s: %v", endpoint, mErr))
+					}
 					disk.Close()
 					s.erasureDisksMu.Unlock()
 					return
@@ -89,6 +89,11 @@ func (evnot *EventNotifier) Targets() []event.Target {
 	return evnot.targetList.Targets()
 }
 
+// Stats returns delivery stats, keyed by target ID, for all registered targets.
+func (evnot *EventNotifier) Stats() event.Stats {
+	return evnot.targetList.Stats()
+}
+
 // InitBucketTargets - initializes event notification system from notification.xml of all buckets.
 func (evnot *EventNotifier) InitBucketTargets(ctx context.Context, objAPI ObjectLayer) error {
 	if objAPI == nil {
@@ -185,6 +190,14 @@ func (args eventArgs) ToEvent(escape bool) event.Event {
 	if args.RespElements["content-length"] != "" {
 		respElements["content-length"] = args.RespElements["content-length"]
 	}
+	// Total time taken to serve the request that triggered this event, so
+	// consumers can correlate an event back to request latency without
+	// cross-referencing audit logs. Added as a response element (rather than
+	// a new top-level Event field) so older consumers that only look at
+	// known fields keep working unmodified.
+	if duration := args.RespElements["x-minio-request-duration-ns"]; duration != "" {
+		respElements["x-minio-request-duration-ns"] = duration
+	}
 
 	keyName := args.Object.Name
 	if escape {
@@ -168,6 +168,21 @@ func newFormatErasureV3(numSets int, setLen int) *formatErasureV3 {
 
 // Returns format Erasure version after reading `format.json`, returns
 // successfully the version only if the backend is Erasure.
+//
+// There is intentionally no single-node FS backend, NAS gateway, or any
+// other gateway mode to fall back to here: those were all retired, and
+// xl-single (single-drive erasure) is the only supported replacement for a
+// shared-filesystem-backed deployment. A format.json predating that removal
+// is reported below rather than silently reinterpreted.
+//
+// This is also why there is no server subcommand to migrate data from an FS
+// deployment into an XL erasure set: the FS backend (ObjectLayer
+// implementation, fs.json layout, the lot) is gone from this tree, so there
+// is nothing left to read objects and metadata from at the server side. The
+// closest a deployment can get is standing up a fresh xl-single or XL
+// cluster and copying the old FS volume's bucket directories across at the
+// filesystem level (e.g. rsync) before pointing MinIO at the new drives -
+// that path predates and does not depend on any code here.
 func formatGetBackendErasureVersion(b []byte) (string, error) {
 	meta := &formatMetaV1{}
 	if err := json.Unmarshal(b, meta); err != nil {
@@ -427,6 +442,20 @@ func checkFormatErasureValue(formatErasure *formatErasureV3, disk StorageAPI) er
 	if formatErasure.Erasure.Version != formatErasureVersionV3 {
 		return fmt.Errorf("Unsupported Erasure backend format found [%s] on %s", formatErasure.Erasure.Version, disk)
 	}
+	switch formatErasure.Erasure.DistributionAlgo {
+	case formatErasureVersionV2DistributionAlgoV1, formatErasureVersionV3DistributionAlgoV2, formatErasureVersionV3DistributionAlgoV3:
+	default:
+		// An unrecognized algorithm would otherwise only surface much later,
+		// as an out-of-range panic the first time an object is hashed to a
+		// set in getHashedSetIndex().
+		//
+		// This check only rejects a distribution algorithm format.json
+		// doesn't already know how to honor - the consistent hashing-based
+		// placement across erasure sets itself is not new, it is the
+		// existing sipHashMod/crcHashMod/hashKey logic in erasure-sets.go
+		// that getHashedSetIndex already calls on every PutObject/GetObject.
+		return fmt.Errorf("Unsupported distribution algorithm found [%s] on %s", formatErasure.Erasure.DistributionAlgo, disk)
+	}
 	return nil
 }
 
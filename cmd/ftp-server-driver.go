@@ -328,7 +328,7 @@ func (driver *ftpDriver) getMinIOClient(ctx *ftp.Context) (*minio.Client, error)
 				claims[ldapAttribPrefix+attribKey] = attribValue
 			}
 
-			cred, err := auth.GetNewCredentialsWithMetadata(claims, globalActiveCred.SecretKey)
+			cred, err := auth.GetNewCredentialsWithMetadata(claims, globalActiveCred().SecretKey)
 			if err != nil {
 				return nil, err
 			}
@@ -132,6 +132,23 @@ func setRequestLimitMiddleware(h http.Handler) http.Handler {
 			atomic.AddUint64(&globalHTTPStats.rejectedRequestsHeader, 1)
 			return
 		}
+
+		// Reject requests that already declare themselves oversized via
+		// Content-Length before doing any further work - chunked/streaming
+		// uploads (Content-Length == -1) skip this and fall through to the
+		// MaxBytesReader below, which aborts the read once the declared
+		// limit is exceeded.
+		if r.ContentLength > requestMaxBodySize {
+			if ok {
+				tc.FuncName = "handler.ValidRequest"
+				tc.ResponseRecorder.LogErrBody = true
+			}
+
+			defer logger.AuditLog(r.Context(), w, r, mustGetClaimsFromToken(r))
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrEntityTooLarge), r.URL)
+			return
+		}
+
 		// Restricting read data to a given maximum length
 		r.Body = http.MaxBytesReader(w, r.Body, requestMaxBodySize)
 		h.ServeHTTP(w, r)
@@ -617,3 +634,36 @@ func setUploadForwardingMiddleware(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// setIAMReadinessMiddleware rejects S3 API requests with a 503 and a
+// Retry-After header while the IAM subsystem is still loading its caches
+// from the backend. Without this, such requests fall through to policy
+// checks that run against an empty/partial IAM state and are denied with
+// a confusing access-denied error instead of a clear "retry" signal.
+func setIAMReadinessMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Admin, KMS, health, metrics and STS APIs track/report their own
+		// readiness (STS responses use a different error schema than S3),
+		// and RPC/browser requests must remain reachable regardless of IAM
+		// state, so only gate regular S3 API traffic here.
+		if guessIsRPCReq(r) || guessIsBrowserReq(r) || guessIsHealthCheckReq(r) ||
+			guessIsMetricsReq(r) || guessIsLoginSTSReq(r) || isAdminReq(r) || isKMSReq(r) ||
+			globalIAMSys.Initialized() {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		tc, ok := r.Context().Value(mcontext.ContextTraceKey).(*mcontext.TraceCtxt)
+		if ok {
+			tc.FuncName = "handler.IAMReadiness"
+			tc.ResponseRecorder.LogErrBody = true
+		}
+
+		// Mirrors the status reported by the health/readiness endpoints,
+		// so load-balancers and operators see a consistent signal for
+		// why the request was rejected.
+		w.Header().Set(xhttp.MinIOServerStatus, "iam-offline")
+		defer logger.AuditLog(r.Context(), w, r, mustGetClaimsFromToken(r))
+		writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrIAMNotInitialized), r.URL)
+	})
+}
@@ -164,10 +164,13 @@ type serverCtxt struct {
 	UserTimeout         time.Duration
 	IdleTimeout         time.Duration
 	ReadHeaderTimeout   time.Duration
+	ReadTimeout         time.Duration
+	WriteTimeout        time.Duration
 	MaxIdleConnsPerHost int
 
 	SendBufSize, RecvBufSize int
 	CrossDomainXML           string
+	IAMBootstrapFile         string
 	// The layout of disks as interpreted
 	Layout disksLayout
 }
@@ -198,7 +201,11 @@ var (
 	// Disable redirect, default is enabled.
 	globalBrowserRedirect bool
 
-	// globalBrowserConfig Browser user configurable settings
+	// globalBrowserConfig Browser user configurable settings, including
+	// operator-provided branding (see BrandingInfoHandler). Note this only
+	// covers cluster-wide, cosmetic settings: per-user/group access to the
+	// browser UI is enforced by the embedded console's own IAM-backed
+	// login, not by anything in this struct or by globalBrowserEnabled.
 	globalBrowserConfig browser.Config
 
 	// This flag is set to 'true' when MINIO_UPDATE env is set to 'off'. Default is false.
@@ -302,10 +309,18 @@ var (
 	globalBucketConnStats = newBucketConnStats()
 	globalBucketHTTPStats = newBucketHTTPStats()
 
+	// Tracks requests currently in flight, used to report live (not cumulative)
+	// request/response byte counts for autoscaling and connection draining decisions.
+	globalActiveRequests = newActiveRequestsTracker()
+
 	// Time when the server is started
 	globalBootTime = UTCNow()
 
-	globalActiveCred         auth.Credentials
+	// globalActiveCredStore is the storage backing globalActiveCred()/
+	// setGlobalActiveCred() in cmd/root-credential-rotation.go. Do not read
+	// or write it directly - it is mutated after startup by root credential
+	// rotation while it is being read on every incoming request.
+	globalActiveCredStore    auth.Credentials
 	globalNodeAuthToken      string
 	globalSiteReplicatorCred siteReplicatorCred
 
@@ -446,6 +461,25 @@ var (
 	// Is MINIO_SYNC_BOOT set?
 	globalEnableSyncBoot bool
 
+	// Is anonymous usage telemetry collection enabled? Off by default,
+	// enabled explicitly via MINIO_ANONYMOUS_TELEMETRY.
+	globalTelemetryEnabled bool
+
+	// Optional endpoint anonymized telemetry snapshots are periodically
+	// pushed to; empty means the telemetry endpoint is only ever read
+	// on-demand via the admin API, nothing is pushed anywhere.
+	globalTelemetryEndpoint string
+
+	// How often to push to globalTelemetryEndpoint, when set.
+	globalTelemetryFrequency = 24 * time.Hour
+
+	// Is signature mismatch debugging enabled? Off by default, enabled
+	// explicitly via MINIO_SIGNATURE_DEBUG. When on, a SignatureDoesNotMatch
+	// error logs (and traces, see madmin.TraceS3) the canonical request and
+	// string-to-sign MinIO computed, to help debug exotic SDKs and proxies.
+	// This is never included in the client response.
+	globalSignatureDebugMode bool
+
 	// Contains NIC interface name used for internode communication
 	globalInternodeInterface     string
 	globalInternodeInterfaceOnce sync.Once
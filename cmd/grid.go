@@ -52,6 +52,7 @@ func initGlobalGrid(ctx context.Context, eps EndpointServerPools) error {
 			newCachedAuthToken(),
 			&tls.Config{
 				RootCAs:          globalRootCAs,
+				MinVersion:       tlsMinVersion(),
 				CipherSuites:     fips.TLSCiphers(),
 				CurvePreferences: fips.TLSCurveIDs(),
 			}),
@@ -85,6 +86,7 @@ func initGlobalLockGrid(ctx context.Context, eps EndpointServerPools) error {
 			newCachedAuthToken(),
 			&tls.Config{
 				RootCAs:          globalRootCAs,
+				MinVersion:       tlsMinVersion(),
 				CipherSuites:     fips.TLSCiphers(),
 				CurvePreferences: fips.TLSCurveIDs(),
 			}, grid.RouteLockPath),
@@ -32,6 +32,7 @@ import (
 	"github.com/shirou/gopsutil/v3/mem"
 
 	"github.com/minio/minio/internal/config/api"
+	"github.com/minio/minio/internal/handlers"
 	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/minio/internal/mcontext"
@@ -41,6 +42,7 @@ type apiConfig struct {
 	mu sync.RWMutex
 
 	requestsPool           chan struct{}
+	anonRequestsPool       chan struct{}
 	clusterDeadline        time.Duration
 	listQuorum             string
 	corsAllowOrigins       []string
@@ -62,6 +64,14 @@ type apiConfig struct {
 const (
 	cgroupV1MemLimitFile = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
 	cgroupV2MemLimitFile = "/sys/fs/cgroup/memory.max"
+
+	// anonRequestsPoolFraction is the share of the memory-derived request
+	// budget set aside exclusively for anonymous (unauthenticated) S3 calls,
+	// e.g. public bucket policy reads. Carving out a fixed share keeps a
+	// burst of anonymous traffic from starving authenticated callers out of
+	// the entire pool, without growing the total in-flight request budget
+	// the memory sizing in init() was computed for.
+	anonRequestsPoolFraction = 4
 )
 
 func cgroupMemLimit() (limit uint64) {
@@ -153,13 +163,28 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int, legacy bool) {
 		logger.Info("Configured max API requests per node based on available memory: %d", apiRequestsMaxPerNode)
 	}
 
-	if cap(t.requestsPool) != apiRequestsMaxPerNode {
+	// Reserve a fixed share of the budget for anonymous requests so they
+	// cannot consume the whole pool and starve authenticated traffic; the
+	// remainder stays available to authenticated (and admin-delegated S3)
+	// requests. Admin API and health-check routes never go through this
+	// pool at all - see s3APIMiddleware vs the admin/health routers - so
+	// they cannot be starved by either class here.
+	apiRequestsMaxAnonPerNode := apiRequestsMaxPerNode / anonRequestsPoolFraction
+	if apiRequestsMaxAnonPerNode < 1 && apiRequestsMaxPerNode > 0 {
+		apiRequestsMaxAnonPerNode = 1
+	}
+	apiRequestsMaxAuthPerNode := apiRequestsMaxPerNode - apiRequestsMaxAnonPerNode
+
+	if cap(t.requestsPool) != apiRequestsMaxAuthPerNode {
 		// Only replace if needed.
 		// Existing requests will use the previous limit,
 		// but new requests will use the new limit.
 		// There will be a short overlap window,
 		// but this shouldn't last long.
-		t.requestsPool = make(chan struct{}, apiRequestsMaxPerNode)
+		t.requestsPool = make(chan struct{}, apiRequestsMaxAuthPerNode)
+	}
+	if cap(t.anonRequestsPool) != apiRequestsMaxAnonPerNode {
+		t.anonRequestsPool = make(chan struct{}, apiRequestsMaxAnonPerNode)
 	}
 	listQuorum := cfg.ListQuorum
 	if listQuorum == "" {
@@ -188,6 +213,11 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int, legacy bool) {
 	t.syncEvents = cfg.SyncEvents
 	t.objectMaxVersions = cfg.ObjectMaxVersions
 
+	if err := handlers.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		// Already validated in api.LookupConfig, should not happen.
+		logger.LogIf(GlobalContext, "api", err)
+	}
+
 	if t.staleUploadsCleanupInterval != cfg.StaleUploadsCleanupInterval {
 		t.staleUploadsCleanupInterval = cfg.StaleUploadsCleanupInterval
 
@@ -306,6 +336,24 @@ func (t *apiConfig) getRequestsPool() chan struct{} {
 	return t.requestsPool
 }
 
+func (t *apiConfig) getAnonRequestsPoolCapacity() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return cap(t.anonRequestsPool)
+}
+
+func (t *apiConfig) getAnonRequestsPool() chan struct{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.anonRequestsPool == nil {
+		return nil
+	}
+
+	return t.anonRequestsPool
+}
+
 // maxClients throttles the S3 API calls
 func maxClients(f http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -326,7 +374,15 @@ func maxClients(f http.HandlerFunc) http.HandlerFunc {
 		}
 
 		globalHTTPStats.addRequestsInQueue(1)
-		pool := globalAPIConfig.getRequestsPool()
+		// Anonymous (unauthenticated) calls - typically public bucket policy
+		// reads - are admitted through their own reserved pool so a burst of
+		// them cannot exhaust the budget authenticated callers depend on.
+		var pool chan struct{}
+		if getRequestAuthType(r) == authTypeAnonymous {
+			pool = globalAPIConfig.getAnonRequestsPool()
+		} else {
+			pool = globalAPIConfig.getRequestsPool()
+		}
 		if pool == nil {
 			globalHTTPStats.addRequestsInQueue(-1)
 			f.ServeHTTP(w, r)
@@ -24,7 +24,9 @@ import (
 	"net/http"
 	"net/textproto"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/auth"
@@ -270,16 +272,22 @@ func extractReqParams(r *http.Request) map[string]string {
 }
 
 // Extract response elements to be sent with event notification.
-func extractRespElements(w http.ResponseWriter) map[string]string {
+func extractRespElements(w http.ResponseWriter, r *http.Request) map[string]string {
 	if w == nil {
 		return map[string]string{}
 	}
-	return map[string]string{
+	elements := map[string]string{
 		"requestId":      w.Header().Get(xhttp.AmzRequestID),
 		"nodeId":         w.Header().Get(xhttp.AmzRequestHostID),
 		"content-length": w.Header().Get(xhttp.ContentLength),
 		// Add more fields here.
 	}
+	if r != nil {
+		if tc, ok := r.Context().Value(mcontext.ContextTraceKey).(*mcontext.TraceCtxt); ok && tc != nil {
+			elements["x-minio-request-duration-ns"] = strconv.FormatInt(time.Since(tc.ResponseRecorder.StartTime).Nanoseconds(), 10)
+		}
+	}
+	return elements
 }
 
 // Trims away `aws-chunked` from the content-encoding header if present.
@@ -341,6 +349,7 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 		tc, _ := r.Context().Value(mcontext.ContextTraceKey).(*mcontext.TraceCtxt)
 		if tc != nil {
 			globalHTTPStats.updateStats(api, tc.ResponseRecorder)
+			globalTelemetry.observe(api, int64(tc.RequestRecorder.Size())+int64(tc.ResponseRecorder.Size()))
 			globalConnStats.incS3InputBytes(int64(tc.RequestRecorder.Size()))
 			globalConnStats.incS3OutputBytes(int64(tc.ResponseRecorder.Size()))
 
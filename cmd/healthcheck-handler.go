@@ -127,10 +127,12 @@ func ClusterReadCheckHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // ReadinessCheckHandler checks whether MinIO is up and ready to serve requests.
-// It also checks whether the KMS is available and whether etcd is reachable,
-// if configured.
+// It verifies that the object layer is initialized, that IAM is initialized,
+// that read quorum is available across drives, and that the KMS and etcd (if
+// configured) are reachable.
 func ReadinessCheckHandler(w http.ResponseWriter, r *http.Request) {
-	if objLayer := newObjectLayerFn(); objLayer == nil {
+	objLayer := newObjectLayerFn()
+	if objLayer == nil {
 		w.Header().Set(xhttp.MinIOServerStatus, unavailable) // Service not initialized yet
 	}
 	if r.Header.Get(xhttp.MinIOPeerCall) != "" {
@@ -138,6 +140,17 @@ func ReadinessCheckHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if objLayer == nil {
+		writeResponse(w, http.StatusServiceUnavailable, nil, mimeNone)
+		return
+	}
+
+	if !globalIAMSys.Initialized() {
+		w.Header().Set(xhttp.MinIOServerStatus, "iam-offline")
+		writeResponse(w, http.StatusServiceUnavailable, nil, mimeNone)
+		return
+	}
+
 	if int(globalHTTPStats.loadRequestsInQueue()) > globalAPIConfig.getRequestsPoolCapacity() {
 		apiErr := getAPIError(ErrBusy)
 		switch r.Method {
@@ -183,6 +196,22 @@ func ReadinessCheckHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+
+	// Verify read quorum is available across drives before declaring
+	// ready, so load balancers don't send traffic to a node that is up
+	// but can't actually serve reads yet (e.g. too many drives offline).
+	ctx, hcancel := context.WithTimeout(r.Context(), defaultContextTimeout)
+	defer hcancel()
+	if result := objLayer.Health(ctx, HealthOptions{}); !result.HealthyRead {
+		switch r.Method {
+		case http.MethodHead:
+			writeResponse(w, http.StatusServiceUnavailable, nil, mimeNone)
+		case http.MethodGet:
+			writeErrorResponse(r.Context(), w, getAPIError(ErrServerNotInitialized), r.URL)
+		}
+		return
+	}
+
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
 
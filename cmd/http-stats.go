@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 
 	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/mcontext"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -92,6 +93,30 @@ func newConnStats() *connStats {
 	return &connStats{}
 }
 
+// NetworkThroughputInfo holds cumulative network byte counters for a single
+// node, reported over peer RPC so `mc admin info`-style tooling can display
+// per-node network throughput across a cluster.
+type NetworkThroughputInfo struct {
+	Addr                 string `json:"addr"`
+	S3InputBytes         uint64 `json:"s3InputBytes"`
+	S3OutputBytes        uint64 `json:"s3OutputBytes"`
+	InternodeInputBytes  uint64 `json:"internodeInputBytes"`
+	InternodeOutputBytes uint64 `json:"internodeOutputBytes"`
+	Error                string `json:"error,omitempty"`
+}
+
+// getLocalNetworkThroughput returns the local node's cumulative network byte counters.
+func getLocalNetworkThroughput() NetworkThroughputInfo {
+	connStats := globalConnStats.toServerConnStats()
+	return NetworkThroughputInfo{
+		Addr:                 globalLocalNodeName,
+		S3InputBytes:         connStats.s3InputBytes,
+		S3OutputBytes:        connStats.s3OutputBytes,
+		InternodeInputBytes:  connStats.internodeInputBytes,
+		InternodeOutputBytes: connStats.internodeOutputBytes,
+	}
+}
+
 type bucketS3RXTX struct {
 	s3InputBytes  uint64
 	s3OutputBytes uint64
@@ -456,3 +481,44 @@ func (st *HTTPStats) updateStats(api string, w *xhttp.ResponseRecorder) {
 func newHTTPStats() *HTTPStats {
 	return &HTTPStats{}
 }
+
+// activeRequestsTracker keeps track of the trace context of every request
+// currently being served, so that the bytes read/written so far by in-flight
+// requests can be added up on demand, without having to estimate them ahead
+// of time from (often absent) Content-Length headers.
+type activeRequestsTracker struct {
+	requests sync.Map // *mcontext.TraceCtxt -> struct{}
+}
+
+func newActiveRequestsTracker() *activeRequestsTracker {
+	return &activeRequestsTracker{}
+}
+
+// add registers a request as in-flight.
+func (a *activeRequestsTracker) add(tc *mcontext.TraceCtxt) {
+	a.requests.Store(tc, struct{}{})
+}
+
+// remove unregisters a request once it has been fully served.
+func (a *activeRequestsTracker) remove(tc *mcontext.TraceCtxt) {
+	a.requests.Delete(tc)
+}
+
+// inFlightBytes returns the total number of request and response bytes
+// transferred so far by all currently in-flight requests.
+func (a *activeRequestsTracker) inFlightBytes() (rx, tx uint64) {
+	a.requests.Range(func(key, _ any) bool {
+		tc, ok := key.(*mcontext.TraceCtxt)
+		if !ok || tc == nil {
+			return true
+		}
+		if tc.RequestRecorder != nil {
+			rx += uint64(tc.RequestRecorder.Size())
+		}
+		if tc.ResponseRecorder != nil {
+			tx += uint64(tc.ResponseRecorder.Size())
+		}
+		return true
+	})
+	return rx, tx
+}
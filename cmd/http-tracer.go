@@ -85,6 +85,9 @@ func httpTracerMiddleware(h http.Handler) http.Handler {
 
 		r = r.WithContext(context.WithValue(r.Context(), mcontext.ContextTraceKey, &tc))
 
+		globalActiveRequests.add(&tc)
+		defer globalActiveRequests.remove(&tc)
+
 		reqStartTime := time.Now().UTC()
 		h.ServeHTTP(respRecorder, r)
 		reqEndTime := time.Now().UTC()
@@ -0,0 +1,194 @@
+// Copyright (c) 2015-2026 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// applyIAMBootstrapFile reconciles IAM policies, users, groups and policy
+// attachments from the file at globalServerCtxt.IAMBootstrapFile, if one was
+// given via --iam-bootstrap/MINIO_IAM_BOOTSTRAP_FILE. The file uses the same
+// zip layout produced by 'mc admin cluster iam export', which lets a GitOps
+// pipeline export from one deployment and feed the result straight into
+// another's startup.
+//
+// Unlike ImportIAM, this never deletes or disables anything: an empty policy
+// entry (the zip format's deletion marker) and any mapping not present in
+// the file are simply left untouched, since the file is meant to describe a
+// baseline to converge towards, not the full desired state.
+func applyIAMBootstrapFile(ctx context.Context) {
+	path := globalServerCtxt.IAMBootstrapFile
+	if path == "" {
+		return
+	}
+
+	if err := doApplyIAMBootstrapFile(ctx, path); err != nil {
+		iamLogIf(ctx, fmt.Errorf("unable to apply IAM bootstrap file %s: %w", path, err), logger.WarningKind)
+	}
+}
+
+func doApplyIAMBootstrapFile(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+
+	var added, failed int
+
+	if f, err := zr.Open(pathJoin(iamAssetsDir, allPoliciesFile)); err == nil {
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		var allPolicies map[string]policy.Policy
+		if err := json.Unmarshal(data, &allPolicies); err != nil {
+			return err
+		}
+		for policyName, p := range allPolicies {
+			if p.IsEmpty() {
+				// Deletion marker in the export format; bootstrap never deletes.
+				continue
+			}
+			if _, err := globalIAMSys.SetPolicy(ctx, policyName, p); err != nil {
+				failed++
+				iamLogIf(ctx, fmt.Errorf("iam-bootstrap: policy %s: %w", policyName, err), logger.WarningKind)
+				continue
+			}
+			added++
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if f, err := zr.Open(pathJoin(iamAssetsDir, allUsersFile)); err == nil {
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		var userAccts map[string]madmin.AddOrUpdateUserReq
+		if err := json.Unmarshal(data, &userAccts); err != nil {
+			return err
+		}
+		for accessKey, ureq := range userAccts {
+			if accessKey == globalActiveCred().AccessKey {
+				continue
+			}
+			if user, exists := globalIAMSys.GetUser(ctx, accessKey); exists &&
+				(user.Credentials.IsTemp() || user.Credentials.IsServiceAccount()) {
+				// Bootstrap only manages regular users, not STS or service accounts.
+				continue
+			}
+			if _, err := globalIAMSys.CreateUser(ctx, accessKey, ureq); err != nil {
+				failed++
+				iamLogIf(ctx, fmt.Errorf("iam-bootstrap: user %s: %w", accessKey, err), logger.WarningKind)
+				continue
+			}
+			added++
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if f, err := zr.Open(pathJoin(iamAssetsDir, allGroupsFile)); err == nil {
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		var grpInfos map[string]GroupInfo
+		if err := json.Unmarshal(data, &grpInfos); err != nil {
+			return err
+		}
+		for group, grpInfo := range grpInfos {
+			if _, err := globalIAMSys.AddUsersToGroup(ctx, group, grpInfo.Members); err != nil {
+				failed++
+				iamLogIf(ctx, fmt.Errorf("iam-bootstrap: group %s: %w", group, err), logger.WarningKind)
+				continue
+			}
+			added++
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if f, err := zr.Open(pathJoin(iamAssetsDir, userPolicyMappingsFile)); err == nil {
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		var userPolicyMap map[string]MappedPolicy
+		if err := json.Unmarshal(data, &userPolicyMap); err != nil {
+			return err
+		}
+		for u, pm := range userPolicyMap {
+			if _, err := globalIAMSys.PolicyDBSet(ctx, u, pm.Policies, regUser, false); err != nil {
+				failed++
+				iamLogIf(ctx, fmt.Errorf("iam-bootstrap: user policy mapping %s: %w", u, err), logger.WarningKind)
+				continue
+			}
+			added++
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if f, err := zr.Open(pathJoin(iamAssetsDir, groupPolicyMappingsFile)); err == nil {
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		var groupPolicyMap map[string]MappedPolicy
+		if err := json.Unmarshal(data, &groupPolicyMap); err != nil {
+			return err
+		}
+		for g, pm := range groupPolicyMap {
+			if _, err := globalIAMSys.PolicyDBSet(ctx, g, pm.Policies, unknownIAMUserType, true); err != nil {
+				failed++
+				iamLogIf(ctx, fmt.Errorf("iam-bootstrap: group policy mapping %s: %w", g, err), logger.WarningKind)
+				continue
+			}
+			added++
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	logger.Info("IAM bootstrap from %s applied %d entries (%d failed)", path, added, failed)
+	return nil
+}
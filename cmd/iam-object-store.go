@@ -102,7 +102,7 @@ func decryptData(data []byte, objPath string) ([]byte, error) {
 		return data, nil
 	}
 
-	pdata, err := madmin.DecryptData(globalActiveCred.String(), bytes.NewReader(data))
+	pdata, err := madmin.DecryptData(globalActiveCred().String(), bytes.NewReader(data))
 	if err == nil {
 		return pdata, nil
 	}
@@ -166,10 +166,12 @@ func newUserIdentity(cred auth.Credentials) UserIdentity {
 
 // GroupInfo contains info about a group
 type GroupInfo struct {
-	Version   int       `json:"version"`
-	Status    string    `json:"status"`
-	Members   []string  `json:"members"`
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	Version     int               `json:"version"`
+	Status      string            `json:"status"`
+	Members     []string          `json:"members"`
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	UpdatedAt   time.Time         `json:"updatedAt,omitempty"`
 }
 
 func newGroupInfo(members []string) GroupInfo {
@@ -710,6 +712,33 @@ func (store *IAMStoreSys) GetMappedPolicy(name string, isGroup bool) (MappedPoli
 	return cache.iamUserPolicyMap.Load(name)
 }
 
+// IAMCacheStats reports the number of entries of each kind currently held in
+// the in-memory IAM cache.
+type IAMCacheStats struct {
+	Users               int
+	STSAccounts         int
+	Groups              int
+	Policies            int
+	UserPolicyMappings  int
+	GroupPolicyMappings int
+}
+
+// CacheStats - returns counts of the various entries currently held in the
+// in-memory IAM cache, without touching backend storage.
+func (store *IAMStoreSys) CacheStats() IAMCacheStats {
+	cache := store.rlock()
+	defer store.runlock()
+
+	return IAMCacheStats{
+		Users:               len(cache.iamUsersMap),
+		STSAccounts:         len(cache.iamSTSAccountsMap),
+		Groups:              len(cache.iamGroupsMap),
+		Policies:            len(cache.iamPolicyDocsMap),
+		UserPolicyMappings:  cache.iamUserPolicyMap.Size(),
+		GroupPolicyMappings: cache.iamGroupPolicyMap.Size(),
+	}
+}
+
 // GroupNotificationHandler - updates in-memory cache on notification of
 // change (e.g. peer notification for object storage and etcd watch
 // notification).
@@ -944,6 +973,49 @@ func (store *IAMStoreSys) SetGroupStatus(ctx context.Context, group string, enab
 	return gi.UpdatedAt, nil
 }
 
+// SetGroupDescription - sets the free-form description and tags for a
+// group. Does not touch membership or status.
+func (store *IAMStoreSys) SetGroupDescription(ctx context.Context, group, description string, tags map[string]string) (updatedAt time.Time, err error) {
+	if group == "" {
+		return updatedAt, errInvalidArgument
+	}
+
+	cache := store.lock()
+	defer store.unlock()
+
+	gi, ok := cache.iamGroupsMap[group]
+	if !ok {
+		return updatedAt, errNoSuchGroup
+	}
+
+	gi.Description = description
+	gi.Tags = tags
+	gi.UpdatedAt = UTCNow()
+	if err := store.saveGroupInfo(ctx, group, gi); err != nil {
+		return gi.UpdatedAt, err
+	}
+
+	cache.iamGroupsMap[group] = gi
+	cache.updatedAt = time.Now()
+
+	return gi.UpdatedAt, nil
+}
+
+// GroupTags - returns the tags set on a group, for use as policy condition
+// values (see getConditionValues). Returns nil for a non-existent group
+// rather than an error, since it is consulted for every group a credential
+// claims membership in while evaluating a request.
+func (store *IAMStoreSys) GroupTags(group string) map[string]string {
+	cache := store.rlock()
+	defer store.runlock()
+
+	gi, ok := cache.iamGroupsMap[group]
+	if !ok {
+		return nil
+	}
+	return gi.Tags
+}
+
 // GetGroupDescription - builds up group description
 func (store *IAMStoreSys) GetGroupDescription(group string) (gd madmin.GroupDesc, err error) {
 	cache := store.rlock()
@@ -2049,7 +2121,7 @@ func (store *IAMStoreSys) getParentUsers(cache *iamCache) map[string]ParentUserI
 		if err != nil {
 			continue
 		}
-		if cred.ParentUser == "" || cred.ParentUser == globalActiveCred.AccessKey {
+		if cred.ParentUser == "" || cred.ParentUser == globalActiveCred().AccessKey {
 			continue
 		}
 
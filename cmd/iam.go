@@ -238,6 +238,18 @@ func (sys *IAMSys) Load(ctx context.Context, firstTime bool) error {
 	return nil
 }
 
+// CacheStats - returns counts of the various entries currently held in the
+// in-memory IAM cache, so operators can verify that IAM changes have
+// propagated. Timing of the last refresh cycle is not part of the result; it
+// is kept in the LastRefresh* fields of IAMSys.
+func (sys *IAMSys) CacheStats() (IAMCacheStats, error) {
+	if !sys.Initialized() {
+		return IAMCacheStats{}, errServerNotInitialized
+	}
+
+	return sys.store.CacheStats(), nil
+}
+
 // Init - initializes config system by reading entries from config/iam
 func (sys *IAMSys) Init(ctx context.Context, objAPI ObjectLayer, etcdClient *etcd.Client, iamRefreshInterval time.Duration) {
 	bootstrapTraceMsg("IAM initialization started")
@@ -437,6 +449,16 @@ func (sys *IAMSys) periodicRoutines(ctx context.Context, baseInterval time.Durat
 				}
 			}
 
+			// Periodically refresh the JWKS for configured OpenID
+			// providers so that IdP key rotation doesn't surface as
+			// AssumeRoleWithWebIdentity failures for the first caller
+			// to see a new `kid`.
+			if sys.OpenIDConfig.Enabled {
+				if err := sys.OpenIDConfig.RefreshJWKS(); err != nil {
+					iamLogIf(ctx, fmt.Errorf("Failure in refreshing JWKS for OpenID providers: %v", err), logger.WarningKind)
+				}
+			}
+
 			timer.Reset(waitInterval())
 		case <-ctx.Done():
 			return
@@ -792,6 +814,69 @@ func (sys *IAMSys) ListUsers(ctx context.Context) (map[string]madmin.UserInfo, e
 	}
 }
 
+// ListUsersOptions controls pagination and filtering for ListUsersPaged, so
+// that listing the user database doesn't require materializing every user
+// on every call in deployments with a very large number of users.
+type ListUsersOptions struct {
+	// Marker is the access key to resume listing after (exclusive),
+	// as returned by a previous call's NextMarker.
+	Marker string
+	// MaxEntries caps the number of users returned. <= 0 means
+	// unlimited (equivalent to ListUsers).
+	MaxEntries int
+	// Prefix, if set, only returns users whose access key starts with it.
+	Prefix string
+	// Status, if set, only returns users with a matching
+	// madmin.AccountEnabled/madmin.AccountDisabled status.
+	Status madmin.AccountStatus
+}
+
+// ListUsersPaged - lists users like ListUsers, but supports an access-key
+// marker, a maximum result count, and prefix/status filtering.
+func (sys *IAMSys) ListUsersPaged(ctx context.Context, opts ListUsersOptions) (users map[string]madmin.UserInfo, nextMarker string, err error) {
+	if !sys.Initialized() {
+		return nil, "", errServerNotInitialized
+	}
+
+	select {
+	case <-sys.configLoaded:
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	}
+
+	all := sys.store.GetUsers()
+
+	accessKeys := make([]string, 0, len(all))
+	for accessKey := range all {
+		accessKeys = append(accessKeys, accessKey)
+	}
+	sort.Strings(accessKeys)
+
+	users = make(map[string]madmin.UserInfo)
+	var lastAdded string
+	for _, accessKey := range accessKeys {
+		if opts.Marker != "" && accessKey <= opts.Marker {
+			continue
+		}
+		if opts.Prefix != "" && !strings.HasPrefix(accessKey, opts.Prefix) {
+			continue
+		}
+		u := all[accessKey]
+		if opts.Status != "" && u.Status != opts.Status {
+			continue
+		}
+
+		if opts.MaxEntries > 0 && len(users) == opts.MaxEntries {
+			nextMarker = lastAdded
+			break
+		}
+		users[accessKey] = u
+		lastAdded = accessKey
+	}
+
+	return users, nextMarker, nil
+}
+
 // ListLDAPUsers - list LDAP users which has
 func (sys *IAMSys) ListLDAPUsers(ctx context.Context) (map[string]madmin.UserInfo, error) {
 	if !sys.Initialized() {
@@ -1492,7 +1577,7 @@ func (sys *IAMSys) updateGroupMembershipsForLDAP(ctx context.Context) {
 			if cred.IsServiceAccount() {
 				jwtClaims, err = auth.ExtractClaims(cred.SessionToken, cred.SecretKey)
 				if err != nil {
-					jwtClaims, err = auth.ExtractClaims(cred.SessionToken, globalActiveCred.SecretKey)
+					jwtClaims, err = auth.ExtractClaims(cred.SessionToken, globalActiveCred().SecretKey)
 				}
 			} else {
 				var secretKey string
@@ -1793,8 +1878,8 @@ func (sys *IAMSys) CheckKey(ctx context.Context, accessKey string) (u UserIdenti
 		return u, false, nil
 	}
 
-	if accessKey == globalActiveCred.AccessKey {
-		return newUserIdentity(globalActiveCred), true, nil
+	if accessKey == globalActiveCred().AccessKey {
+		return newUserIdentity(globalActiveCred()), true, nil
 	}
 
 	loadUserCalled := false
@@ -1909,6 +1994,36 @@ func (sys *IAMSys) GetGroupDescription(group string) (gd madmin.GroupDesc, err e
 	return sys.store.GetGroupDescription(group)
 }
 
+// SetGroupDescription - sets the free-form description and tags for a group.
+func (sys *IAMSys) SetGroupDescription(ctx context.Context, group, description string, tags map[string]string) (updatedAt time.Time, err error) {
+	if !sys.Initialized() {
+		return updatedAt, errServerNotInitialized
+	}
+
+	if sys.usersSysType != MinIOUsersSysType {
+		return updatedAt, errIAMActionNotAllowed
+	}
+
+	updatedAt, err = sys.store.SetGroupDescription(ctx, group, description, tags)
+	if err != nil {
+		return updatedAt, err
+	}
+
+	sys.notifyForGroup(ctx, group)
+	return updatedAt, nil
+}
+
+// GroupTags - returns the tags set on a group, used as policy condition
+// values so that statements can restrict access by group tag (e.g.
+// "department": "finance"), the same way object/bucket tags are used.
+func (sys *IAMSys) GroupTags(group string) map[string]string {
+	if !sys.Initialized() {
+		return nil
+	}
+
+	return sys.store.GroupTags(group)
+}
+
 // ListGroups - lists groups.
 func (sys *IAMSys) ListGroups(ctx context.Context) (r []string, err error) {
 	if !sys.Initialized() {
@@ -1984,7 +2099,7 @@ func (sys *IAMSys) PolicyDBUpdateBuiltin(ctx context.Context, isAttach bool,
 
 		// When the user is root credential you are not allowed to
 		// add policies for root user.
-		if userOrGroup == globalActiveCred.AccessKey {
+		if userOrGroup == globalActiveCred().AccessKey {
 			err = errIAMActionNotAllowed
 			return
 		}
@@ -2159,7 +2274,7 @@ func (sys *IAMSys) IsAllowedServiceAccount(args policy.Args, parentUser string)
 		return false
 	}
 
-	isOwnerDerived := parentUser == globalActiveCred.AccessKey
+	isOwnerDerived := parentUser == globalActiveCred().AccessKey
 
 	var err error
 	var svcPolicies []string
@@ -2243,7 +2358,7 @@ func (sys *IAMSys) IsAllowedServiceAccount(args policy.Args, parentUser string)
 func (sys *IAMSys) IsAllowedSTS(args policy.Args, parentUser string) bool {
 	// 1. Determine mapped policies
 
-	isOwnerDerived := parentUser == globalActiveCred.AccessKey
+	isOwnerDerived := parentUser == globalActiveCred().AccessKey
 	var policies []string
 	roleArn := args.GetRoleArn()
 
@@ -2436,6 +2551,12 @@ func (sys *IAMSys) doesPolicyAllow(policy string, args policy.Args) bool {
 
 // IsAllowed - checks given policy args is allowed to continue the Rest API.
 func (sys *IAMSys) IsAllowed(args policy.Args) bool {
+	return sys.isAllowedByPolicy(args)
+}
+
+// isAllowedByPolicy - checks given policy args is allowed per the account's
+// attached policies (or an external authorization plugin, if configured).
+func (sys *IAMSys) isAllowedByPolicy(args policy.Args) bool {
 	// Log the input
 	log.Printf("Checking if allowed for args: %v", args)
 	// If opa is configured, use OPA always.
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ignoredDisksFile is the name of the node-local state file, stored under
+// globalConfigDir, that records drives this node has chosen to stop
+// connecting to: drives taken offline by a failed health check, and drives
+// refused for an unexpected drive ordering. Unlike the format.json
+// on each drive, this file intentionally lives off the erasure drives
+// themselves -- a drive that is dead or unreadable at boot is exactly the
+// drive we need to remember to skip.
+const ignoredDisksFile = "ignored-disks.json"
+
+// ignoredDiskInfo records why and when a drive was marked ignored.
+type ignoredDiskInfo struct {
+	Reason    string    `json:"reason"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ignoredDisksStore is a node-local, JSON-persisted record of drives that
+// were taken offline or refused (see ignoredDisksFile) and are not reconnected to on
+// subsequent restarts until explicitly cleared by an admin. It is
+// deliberately simple (no distributed state, no locking across nodes)
+// because each node only ever manages the drives attached to it.
+type ignoredDisksStore struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]ignoredDiskInfo
+}
+
+var globalIgnoredDisks = &ignoredDisksStore{}
+
+// init loads any previously persisted ignored-disk entries from disk. It is
+// called once, after globalConfigDir has been finalized, and is a no-op if
+// the state file does not exist yet.
+func (s *ignoredDisksStore) init() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.path = filepath.Join(globalConfigDir.Get(), ignoredDisksFile)
+	s.entries = make(map[string]ignoredDiskInfo)
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return
+	}
+
+	// Best-effort load -- a corrupt or unreadable state file should never
+	// prevent the server from starting up.
+	_ = json.Unmarshal(data, &s.entries)
+}
+
+// save persists the current set of entries to disk. Callers must hold s.mu.
+func (s *ignoredDisksStore) save() error {
+	data, err := json.Marshal(s.entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+// Mark records endpoint as ignored with the given reason, persisting the
+// change to disk. Errors persisting are logged by the caller; Mark itself
+// still updates the in-memory state so the current process takes effect
+// immediately.
+func (s *ignoredDisksStore) Mark(endpoint, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]ignoredDiskInfo)
+	}
+
+	s.entries[endpoint] = ignoredDiskInfo{
+		Reason:    reason,
+		Timestamp: time.Now().UTC(),
+	}
+
+	return s.save()
+}
+
+// IsIgnored returns whether endpoint has been marked ignored, and the
+// recorded info if so.
+func (s *ignoredDisksStore) IsIgnored(endpoint string) (ignoredDiskInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, ok := s.entries[endpoint]
+	return info, ok
+}
+
+// List returns a copy of all currently ignored drive entries.
+func (s *ignoredDisksStore) List() map[string]ignoredDiskInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]ignoredDiskInfo, len(s.entries))
+	for k, v := range s.entries {
+		out[k] = v
+	}
+	return out
+}
+
+// Clear removes endpoint from the ignored list, persisting the change. It
+// returns false if endpoint was not present.
+func (s *ignoredDisksStore) Clear(endpoint string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[endpoint]; !ok {
+		return false, nil
+	}
+
+	delete(s.entries, endpoint)
+	return true, s.save()
+}
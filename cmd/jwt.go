@@ -20,12 +20,14 @@ package cmd
 import (
 	"errors"
 	"net/http"
+	"sync"
 	"time"
 
 	jwtgo "github.com/golang-jwt/jwt/v4"
 	jwtreq "github.com/golang-jwt/jwt/v4/request"
 	"github.com/minio/minio/internal/auth"
 	xjwt "github.com/minio/minio/internal/jwt"
+	"github.com/minio/pkg/v3/env"
 	"github.com/minio/pkg/v3/policy"
 )
 
@@ -37,8 +39,38 @@ const (
 
 	// Inter-node JWT token expiry is 100 years approx.
 	defaultInterNodeJWTExpiry = 100 * 365 * 24 * time.Hour
+
+	// internodeJWTIssuer and internodeJWTAudience pin the inter-node JWT to
+	// the purpose it was minted for (storage REST, peer REST and grid RPC
+	// auth all share the same signing secret), so a token cannot be
+	// mistaken for, or replayed as, some other class of signed token.
+	internodeJWTIssuer   = "minio"
+	internodeJWTAudience = "internode-rpc"
+
+	// EnvInternodeJWTExpiry overrides how long a freshly minted inter-node
+	// JWT remains valid for.
+	EnvInternodeJWTExpiry = "_MINIO_INTERNODE_JWT_EXPIRY"
 )
 
+// internodeJWTExpiry is the lifetime assigned to freshly minted inter-node
+// JWTs. newCachedAuthToken re-mints the cached token well before it reaches
+// this age, so shortening this mostly trades a little local re-signing for a
+// smaller window in which a leaked token remains usable.
+var internodeJWTExpiry, _ = env.GetDuration(EnvInternodeJWTExpiry, defaultInterNodeJWTExpiry)
+
+// internodeJWTRenewBefore is how far ahead of expiry newCachedAuthToken
+// re-mints the cached inter-node token. It is a fraction of the configured
+// expiry so that a short EnvInternodeJWTExpiry still renews with margin to
+// spare, while the default ~100 year expiry effectively never renews.
+func internodeJWTRenewBefore() time.Duration {
+	renew := internodeJWTExpiry / 10
+	const maxRenewBefore = 5 * time.Minute
+	if renew > maxRenewBefore {
+		renew = maxRenewBefore
+	}
+	return renew
+}
+
 var (
 	errInvalidAccessKeyID = errors.New("The access key ID you provided does not exist in our records")
 	errAccessKeyDisabled  = errors.New("The access key you provided is disabled")
@@ -50,8 +82,10 @@ var (
 
 func authenticateNode(accessKey, secretKey string) (string, error) {
 	claims := xjwt.NewStandardClaims()
-	claims.SetExpiry(UTCNow().Add(defaultInterNodeJWTExpiry))
+	claims.SetExpiry(UTCNow().Add(internodeJWTExpiry))
 	claims.SetAccessKey(accessKey)
+	claims.SetIssuer(internodeJWTIssuer)
+	claims.SetAudience(internodeJWTAudience)
 
 	jwt := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, claims)
 	return jwt.SignedString([]byte(secretKey))
@@ -70,7 +104,7 @@ func metricsRequestAuthenticate(req *http.Request) (*xjwt.MapClaims, []string, b
 	}
 	claims := xjwt.NewMapClaims()
 	if err := xjwt.ParseWithClaims(token, claims, func(claims *xjwt.MapClaims) ([]byte, error) {
-		if claims.AccessKey != globalActiveCred.AccessKey {
+		if claims.AccessKey != globalActiveCred().AccessKey {
 			u, ok := globalIAMSys.GetUser(req.Context(), claims.AccessKey)
 			if !ok {
 				// Credentials will be invalid but for disabled
@@ -91,13 +125,13 @@ func metricsRequestAuthenticate(req *http.Request) (*xjwt.MapClaims, []string, b
 			// if root access is disabled, fail this request.
 			return nil, errAccessKeyDisabled
 		}
-		return []byte(globalActiveCred.SecretKey), nil
+		return []byte(globalActiveCred().SecretKey), nil
 	}); err != nil {
 		return claims, nil, false, errAuthentication
 	}
 	owner := true
 	var groups []string
-	if globalActiveCred.AccessKey != claims.AccessKey {
+	if globalActiveCred().AccessKey != claims.AccessKey {
 		// Check if the access key is part of users credentials.
 		u, ok := globalIAMSys.GetUser(req.Context(), claims.AccessKey)
 		if !ok {
@@ -115,7 +149,7 @@ func metricsRequestAuthenticate(req *http.Request) (*xjwt.MapClaims, []string, b
 		}
 
 		// if root access is disabled, disable all its service accounts and temporary credentials.
-		if ucred.ParentUser == globalActiveCred.AccessKey && !globalAPIConfig.permitRootAccess() {
+		if ucred.ParentUser == globalActiveCred().AccessKey && !globalAPIConfig.permitRootAccess() {
 			return nil, nil, false, errAccessKeyDisabled
 		}
 
@@ -123,7 +157,7 @@ func metricsRequestAuthenticate(req *http.Request) (*xjwt.MapClaims, []string, b
 		if _, ok = eclaims[policy.SessionPolicyName]; ok {
 			owner = false
 		} else {
-			owner = globalActiveCred.AccessKey == ucred.ParentUser
+			owner = globalActiveCred().AccessKey == ucred.ParentUser
 		}
 
 		groups = ucred.Groups
@@ -132,9 +166,32 @@ func metricsRequestAuthenticate(req *http.Request) (*xjwt.MapClaims, []string, b
 	return claims, groups, owner, nil
 }
 
-// newCachedAuthToken returns the cached token.
+// nodeAuthTokenMu guards globalNodeAuthToken and nodeAuthTokenMintedAt below.
+// Inter-node JWTs are signed locally from globalActiveCred(), so "re-login" on
+// approaching expiry is just re-minting the token; it needs no round-trip to
+// any peer.
+var (
+	nodeAuthTokenMu       sync.Mutex
+	nodeAuthTokenMintedAt time.Time
+)
+
+// newCachedAuthToken returns the cached inter-node JWT, transparently
+// re-minting it once it gets within internodeJWTRenewBefore() of expiry so
+// that long-lived nodes don't start failing storage REST/peer REST/grid auth
+// the moment the cached token ages out.
 func newCachedAuthToken() func() string {
 	return func() string {
+		nodeAuthTokenMu.Lock()
+		defer nodeAuthTokenMu.Unlock()
+
+		if UTCNow().Sub(nodeAuthTokenMintedAt) >= internodeJWTExpiry-internodeJWTRenewBefore() {
+			token, err := authenticateNode(globalActiveCred().AccessKey, globalActiveCred().SecretKey)
+			if err == nil {
+				globalNodeAuthToken = token
+				nodeAuthTokenMintedAt = UTCNow()
+			}
+		}
+
 		return globalNodeAuthToken
 	}
 }
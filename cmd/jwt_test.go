@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	jwtgo "github.com/golang-jwt/jwt/v4"
 	xjwt "github.com/minio/minio/internal/jwt"
@@ -49,7 +50,7 @@ func TestWebRequestAuthenticate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	token, err := getTokenString(creds.AccessKey, creds.SecretKey)
 	if err != nil {
 		t.Fatalf("unable get token %s", err)
@@ -93,6 +94,81 @@ func TestWebRequestAuthenticate(t *testing.T) {
 	}
 }
 
+// Tests that an inter-node JWT is pinned to its issuer/audience and that the
+// cached token transparently re-mints itself once it nears expiry.
+func TestInternodeJWTPinningAndRenewal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDir, err := prepareFS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fsDir)
+	if err = newTestConfig(globalMinioDefaultRegion, obj); err != nil {
+		t.Fatal(err)
+	}
+
+	creds := globalActiveCred()
+	token, err := authenticateNode(creds.AccessKey, creds.SecretKey)
+	if err != nil {
+		t.Fatalf("unable to generate inter-node token: %s", err)
+	}
+	if err = validateStorageRequestToken(token); err != nil {
+		t.Fatalf("expected a freshly minted inter-node token to validate, got %s", err)
+	}
+
+	// A token signed with the same credentials but for a different
+	// issuer/audience must be rejected, even though the signature itself
+	// is valid.
+	claims := xjwt.NewStandardClaims()
+	claims.SetExpiry(UTCNow().Add(defaultJWTExpiry))
+	claims.SetAccessKey(creds.AccessKey)
+	claims.SetIssuer("some-other-purpose")
+	claims.SetAudience("some-other-audience")
+	foreignToken, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, claims).SignedString([]byte(creds.SecretKey))
+	if err != nil {
+		t.Fatalf("unable to sign foreign token: %s", err)
+	}
+	if err = validateStorageRequestToken(foreignToken); err == nil {
+		t.Fatal("expected a token minted for a different issuer/audience to be rejected")
+	}
+
+	// A token minted before issuer/audience pinning (i.e. missing both
+	// claims) must still validate, for rolling-upgrade compatibility.
+	legacyClaims := xjwt.NewStandardClaims()
+	legacyClaims.SetExpiry(UTCNow().Add(defaultJWTExpiry))
+	legacyClaims.SetAccessKey(creds.AccessKey)
+	legacyToken, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, legacyClaims).SignedString([]byte(creds.SecretKey))
+	if err != nil {
+		t.Fatalf("unable to sign legacy token: %s", err)
+	}
+	if err = validateStorageRequestToken(legacyToken); err != nil {
+		t.Fatalf("expected a pre-pinning token to still validate, got %s", err)
+	}
+
+	// Force the cached token to look long overdue for renewal and confirm
+	// that requesting it mints (and validates) a brand new one.
+	defer func(expiry time.Duration, mintedAt time.Time, tok string) {
+		internodeJWTExpiry = expiry
+		nodeAuthTokenMintedAt = mintedAt
+		globalNodeAuthToken = tok
+	}(internodeJWTExpiry, nodeAuthTokenMintedAt, globalNodeAuthToken)
+
+	internodeJWTExpiry = time.Minute
+	nodeAuthTokenMintedAt = UTCNow().Add(-time.Hour)
+	staleToken := globalNodeAuthToken
+
+	fn := newCachedAuthToken()
+	renewed := fn()
+	if renewed == staleToken {
+		t.Fatal("expected an overdue cached token to be re-minted")
+	}
+	if err = validateStorageRequestToken(renewed); err != nil {
+		t.Fatalf("expected the re-minted token to validate, got %s", err)
+	}
+}
+
 func BenchmarkParseJWTStandardClaims(b *testing.B) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -106,7 +182,7 @@ func BenchmarkParseJWTStandardClaims(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	token, err := authenticateNode(creds.AccessKey, creds.SecretKey)
 	if err != nil {
 		b.Fatal(err)
@@ -137,7 +213,7 @@ func BenchmarkParseJWTMapClaims(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	token, err := authenticateNode(creds.AccessKey, creds.SecretKey)
 	if err != nil {
 		b.Fatal(err)
@@ -170,7 +246,7 @@ func BenchmarkAuthenticateNode(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	b.Run("uncached", func(b *testing.B) {
 		fn := authenticateNode
 		b.ResetTimer()
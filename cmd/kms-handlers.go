@@ -21,6 +21,7 @@ import (
 	"crypto/subtle"
 	"encoding/json"
 	"net/http"
+	"sort"
 
 	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio/internal/auth"
@@ -314,6 +315,77 @@ func (a kmsAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Reque
 	writeSuccessResponseJSON(w, resp)
 }
 
+// kmsKeyUsage describes how many buckets use a given KMS master key as
+// their default bucket encryption key.
+type kmsKeyUsage struct {
+	KeyID   string   `json:"keyID"`
+	Buckets []string `json:"buckets"`
+}
+
+// KMSKeysInUseHandler - GET /minio/kms/v1/key/in-use
+//
+// Returns the set of KMS master key IDs that are actually referenced by a
+// bucket's default encryption configuration, grouped by key ID. This is
+// distinct from KMSListKeysHandler, which lists every key known to the KMS
+// whether or not any bucket uses it.
+func (a kmsAPIHandlers) KMSKeysInUseHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "KMSKeysInUse")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, policy.KMSListKeysAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+
+	buckets, err := objectAPI.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Get the cred and owner for checking authz below.
+	cred, owner, s3Err := validateAdminSignature(ctx, r, "")
+	if s3Err != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	usageByKey := map[string][]string{}
+	for _, bucket := range buckets {
+		sseConfig, _, err := globalBucketMetadataSys.GetSSEConfig(bucket.Name)
+		if err != nil {
+			// No default encryption configured for this bucket, skip it.
+			continue
+		}
+		keyID := sseConfig.KeyID()
+		if keyID == "" {
+			keyID = GlobalKMS.DefaultKey
+		}
+		if !checkKMSActionAllowed(r, owner, cred, policy.KMSListKeysAction, keyID) {
+			continue
+		}
+		usageByKey[keyID] = append(usageByKey[keyID], bucket.Name)
+	}
+
+	usage := make([]kmsKeyUsage, 0, len(usageByKey))
+	for keyID, buckets := range usageByKey {
+		usage = append(usage, kmsKeyUsage{KeyID: keyID, Buckets: buckets})
+	}
+	sort.Slice(usage, func(i, j int) bool { return usage[i].KeyID < usage[j].KeyID })
+
+	resp, err := json.Marshal(usage)
+	if err != nil {
+		writeCustomErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInternalError), err.Error(), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
 // checkKMSActionAllowed checks for authorization for a specific action on a resource.
 func checkKMSActionAllowed(r *http.Request, owner bool, cred auth.Credentials, action policy.KMSAction, resource string) bool {
 	return globalIAMSys.IsAllowed(policy.Args{
@@ -638,7 +638,7 @@ func TestKMSHandlerAdminAPI(t *testing.T) {
 func execKMSTest(t *testing.T, test kmsTestCase, adminTestBed *adminErasureTestBed) {
 	var accessKey, secretKey string
 	if test.asRoot {
-		accessKey, secretKey = globalActiveCred.AccessKey, globalActiveCred.SecretKey
+		accessKey, secretKey = globalActiveCred().AccessKey, globalActiveCred().SecretKey
 	} else {
 		setupKMSUser(t, userAccessKey, userSecretKey, test.policy)
 		accessKey = userAccessKey
@@ -796,8 +796,8 @@ func buildKMSRequest(t *testing.T, method, path, accessKey, secretKey string, qu
 	}
 
 	if accessKey == "" && secretKey == "" {
-		accessKey = globalActiveCred.AccessKey
-		secretKey = globalActiveCred.SecretKey
+		accessKey = globalActiveCred().AccessKey
+		secretKey = globalActiveCred().SecretKey
 	}
 
 	req, err := newTestSignedRequestV4(method, path, 0, nil, accessKey, secretKey, nil)
@@ -59,6 +59,7 @@ func registerKMSRouter(router *mux.Router) {
 		kmsRouter.Methods(http.MethodPost).Path(version+"/key/create").HandlerFunc(gz(httpTraceAll(kmsAPI.KMSCreateKeyHandler))).Queries("key-id", "{key-id:.*}")
 		kmsRouter.Methods(http.MethodGet).Path(version+"/key/list").HandlerFunc(gz(httpTraceAll(kmsAPI.KMSListKeysHandler))).Queries("pattern", "{pattern:.*}")
 		kmsRouter.Methods(http.MethodGet).Path(version + "/key/status").HandlerFunc(gz(httpTraceAll(kmsAPI.KMSKeyStatusHandler)))
+		kmsRouter.Methods(http.MethodGet).Path(version + "/key/in-use").HandlerFunc(gz(httpTraceAll(kmsAPI.KMSKeysInUseHandler)))
 	}
 
 	// If none of the routes match add default error handler routes
@@ -43,7 +43,7 @@ func createLockTestServer(ctx context.Context, t *testing.T) (string, *lockRESTS
 			lockMap: make(map[string][]lockRequesterInfo),
 		},
 	}
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	token, err := authenticateNode(creds.AccessKey, creds.SecretKey)
 	if err != nil {
 		t.Fatal(err)
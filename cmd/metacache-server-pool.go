@@ -92,6 +92,12 @@ func (z *erasureServerPools) listPath(ctx context.Context, o *listPathOptions) (
 	// the non-recursive scan unless explicitly requested.
 	o.IncludeDirectories = o.Separator == slashSeparator
 	if (o.Separator == slashSeparator || o.Separator == "") && !o.Recursive {
+		// An empty separator makes this listing recursive. When
+		// o.Separator == slashSeparator this sets o.Recursive to
+		// false: this is the listing fast path for ListObjectsV2 with
+		// delimiter="/" - each directory is returned as a common prefix
+		// straight from WalkDir's single, non-recursive ListDir call,
+		// without ever walking into its children.
 		o.Recursive = o.Separator != slashSeparator
 		o.Separator = slashSeparator
 	} else {
@@ -29,6 +29,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -982,6 +983,25 @@ type listPathRawOptions struct {
 	finished func(errs []error)
 }
 
+type bestEffortListingCtxKey struct{}
+
+// withBestEffortListing opts the listing performed with ctx into best-effort
+// mode: if fewer than quorum drives are reachable, listPathRaw continues
+// with whatever drives remain online instead of failing the request. Use
+// bestEffortListingDegraded afterwards to find out whether that happened.
+func withBestEffortListing(ctx context.Context) (context.Context, *atomic.Bool) {
+	degraded := new(atomic.Bool)
+	return context.WithValue(ctx, bestEffortListingCtxKey{}, degraded), degraded
+}
+
+// bestEffortListingDegraded reports whether ctx was opted into best-effort
+// listing via withBestEffortListing, and if so, returns the flag that is set
+// once results were served despite not enough drives being reachable.
+func bestEffortListingDegraded(ctx context.Context) *atomic.Bool {
+	degraded, _ := ctx.Value(bestEffortListingCtxKey{}).(*atomic.Bool)
+	return degraded
+}
+
 // listPathRaw will list a path on the provided drives.
 // See listPathRawOptions on how results are delivered.
 // Directories are always returned.
@@ -1160,21 +1180,28 @@ func listPathRaw(ctx context.Context, opts listPathRawOptions) (err error) {
 
 		// Stop if we exceed number of bad disks.
 		if hasErr > 0 && hasErr+fnf > len(disks)-opts.minDisks {
-			if opts.finished != nil {
-				opts.finished(errs)
-			}
-			var combinedErr []string
-			for i, err := range errs {
-				if err != nil {
-					if disks[i] != nil {
-						combinedErr = append(combinedErr,
-							fmt.Sprintf("drive %s returned: %s", disks[i], err))
-					} else {
-						combinedErr = append(combinedErr, err.Error())
+			// Best-effort listing continues with whatever drives are still
+			// responding instead of failing outright, unless every single
+			// drive has failed and there is nothing left to serve from.
+			degraded := bestEffortListingDegraded(ctx)
+			if degraded == nil || hasErr == len(disks) {
+				if opts.finished != nil {
+					opts.finished(errs)
+				}
+				var combinedErr []string
+				for i, err := range errs {
+					if err != nil {
+						if disks[i] != nil {
+							combinedErr = append(combinedErr,
+								fmt.Sprintf("drive %s returned: %s", disks[i], err))
+						} else {
+							combinedErr = append(combinedErr, err.Error())
+						}
 					}
 				}
+				return errors.New(strings.Join(combinedErr, ", "))
 			}
-			return errors.New(strings.Join(combinedErr, ", "))
+			degraded.Store(true)
 		}
 
 		// Break if all at EOF or error.
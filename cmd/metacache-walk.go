@@ -282,13 +282,18 @@ func (s *xlStorage) WalkDir(ctx context.Context, opts WalkDirOptions, wr io.Writ
 		dirStack := make([]string, 0, 5)
 		prefix = "" // Remove prefix after first level as we have already filtered the list.
 		if len(forward) > 0 {
-			// Conservative forwarding. Entries may be either objects or prefixes.
-			for i, entry := range entries {
-				if entry >= forward || strings.HasPrefix(forward, entry) {
-					entries = entries[i:]
-					break
-				}
+			// Entries are sorted, so binary search directly to the
+			// insertion point of forward instead of scanning from the
+			// start of a potentially huge, already-sorted listing.
+			idx := sort.Search(len(entries), func(i int) bool {
+				return entries[i] >= forward
+			})
+			// The entry right before idx may still be a prefix of
+			// forward, e.g. a directory the marker descends into.
+			if idx > 0 && strings.HasPrefix(forward, entries[idx-1]) {
+				idx--
 			}
+			entries = entries[idx:]
 		}
 
 		for _, entry := range entries {
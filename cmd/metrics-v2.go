@@ -23,6 +23,7 @@ import (
 	"math"
 	"net/http"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -296,7 +297,8 @@ const (
 )
 
 const (
-	serverName = "server"
+	serverName   = "server"
+	deploymentID = "deployment_id"
 )
 
 // MetricTypeV2 for the types of metrics supported
@@ -3222,6 +3224,24 @@ func getClusterUsageMetrics(opts MetricsGroupOpts) *MetricsGroupV2 {
 	return mg
 }
 
+// tagsToMetricLabel combines a bucket's tag set into a single, deterministically
+// ordered "key=value,key2=value2" label value for use on usage metrics.
+func tagsToMetricLabel(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+tags[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
 func getBucketUsageMetrics(opts MetricsGroupOpts) *MetricsGroupV2 {
 	mg := &MetricsGroupV2{
 		cacheInterval:    1 * time.Minute,
@@ -3254,16 +3274,27 @@ func getBucketUsageMetrics(opts MetricsGroupOpts) *MetricsGroupV2 {
 		for bucket, usage := range dataUsageInfo.BucketsUsage {
 			quota, _ := globalBucketQuotaSys.Get(ctx, bucket)
 
+			// Bucket tags are reported on the usage metrics (rather than as
+			// their own metric) so that storage usage can be attributed to
+			// a team/project directly from the size/object-count series,
+			// without a separate join. The tag set is combined into a
+			// single label to keep its cardinality bounded by one series
+			// per bucket, regardless of how many tags it has.
+			usageLabels := map[string]string{"bucket": bucket}
+			if tags := tagsToMetricLabel(usage.Tags); tags != "" {
+				usageLabels["tags"] = tags
+			}
+
 			metrics = append(metrics, MetricV2{
 				Description:    getBucketUsageTotalBytesMD(),
 				Value:          float64(usage.Size),
-				VariableLabels: map[string]string{"bucket": bucket},
+				VariableLabels: usageLabels,
 			})
 
 			metrics = append(metrics, MetricV2{
 				Description:    getBucketUsageObjectsTotalMD(),
 				Value:          float64(usage.ObjectsCount),
-				VariableLabels: map[string]string{"bucket": bucket},
+				VariableLabels: usageLabels,
 			})
 
 			metrics = append(metrics, MetricV2{
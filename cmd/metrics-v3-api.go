@@ -31,6 +31,8 @@ const (
 
 	apiRequestsWaitingTotal  MetricName = "waiting_total"
 	apiRequestsIncomingTotal MetricName = "incoming_total"
+	apiRequestsMaxConcurrent MetricName = "max_concurrent_total"
+	apiRequestsPoolInUse     MetricName = "pool_in_use_total"
 
 	apiRequestsInFlightTotal  MetricName = "inflight_total"
 	apiRequestsTotal          MetricName = "total"
@@ -43,6 +45,10 @@ const (
 
 	apiTrafficSentBytes MetricName = "traffic_sent_bytes"
 	apiTrafficRecvBytes MetricName = "traffic_received_bytes"
+
+	apiOpenConnectionsTotal     MetricName = "open_connections_total"
+	apiTrafficSentBytesInFlight MetricName = "traffic_sent_bytes_inflight"
+	apiTrafficRecvBytesInFlight MetricName = "traffic_received_bytes_inflight"
 )
 
 var (
@@ -59,6 +65,10 @@ var (
 		"Total number of requests in the waiting queue", "type")
 	apiRequestsIncomingTotalMD = NewGaugeMD(apiRequestsIncomingTotal,
 		"Total number of incoming requests", "type")
+	apiRequestsMaxConcurrentMD = NewGaugeMD(apiRequestsMaxConcurrent,
+		"Maximum number of concurrent requests admitted before requests are throttled with a 429, derived from available memory unless api.requests_max is set", "type")
+	apiRequestsPoolInUseMD = NewGaugeMD(apiRequestsPoolInUse,
+		"Number of requests currently admitted and in flight, out of max_concurrent_total for the same type", "type")
 
 	apiRequestsInFlightTotalMD = NewGaugeMD(apiRequestsInFlightTotal,
 		"Total number of requests currently in flight", "name", "type")
@@ -80,6 +90,13 @@ var (
 		"Total number of bytes sent", "type")
 	apiTrafficRecvBytesMD = NewCounterMD(apiTrafficRecvBytes,
 		"Total number of bytes received", "type")
+
+	apiOpenConnectionsTotalMD = NewGaugeMD(apiOpenConnectionsTotal,
+		"Total number of open client connections, including idle keep-alives")
+	apiTrafficSentBytesInFlightMD = NewGaugeMD(apiTrafficSentBytesInFlight,
+		"Total number of response bytes sent so far by requests currently in flight", "type")
+	apiTrafficRecvBytesInFlightMD = NewGaugeMD(apiTrafficRecvBytesInFlight,
+		"Total number of request bytes received so far by requests currently in flight", "type")
 )
 
 // loadAPIRequestsHTTPMetrics - reads S3 HTTP metrics.
@@ -102,6 +119,22 @@ func loadAPIRequestsHTTPMetrics(ctx context.Context, m MetricValues, _ *metricsC
 	m.Set(apiRejectedInvalidTotal, float64(httpStats.TotalS3RejectedInvalid), "type", "s3")
 	m.Set(apiRequestsWaitingTotal, float64(httpStats.S3RequestsInQueue), "type", "s3")
 	m.Set(apiRequestsIncomingTotal, float64(httpStats.S3RequestsIncoming), "type", "s3")
+	if poolCapacity := globalAPIConfig.getRequestsPoolCapacity(); poolCapacity > 0 {
+		m.Set(apiRequestsMaxConcurrent, float64(poolCapacity), "type", "s3")
+	}
+	if pool := globalAPIConfig.getRequestsPool(); pool != nil {
+		m.Set(apiRequestsPoolInUse, float64(len(pool)), "type", "s3")
+	}
+	// Anonymous (unauthenticated) S3 calls are admitted through a separate,
+	// reserved pool - see maxClients in handler-api.go - so their budget and
+	// current usage are reported under their own "type" value rather than
+	// being folded into the authenticated "s3" counters above.
+	if poolCapacity := globalAPIConfig.getAnonRequestsPoolCapacity(); poolCapacity > 0 {
+		m.Set(apiRequestsMaxConcurrent, float64(poolCapacity), "type", "s3-anonymous")
+	}
+	if pool := globalAPIConfig.getAnonRequestsPool(); pool != nil {
+		m.Set(apiRequestsPoolInUse, float64(len(pool)), "type", "s3-anonymous")
+	}
 
 	for name, value := range httpStats.CurrentS3Requests.APIStats {
 		m.Set(apiRequestsInFlightTotal, float64(value), "name", name, "type", "s3")
@@ -142,6 +175,14 @@ func loadAPIRequestsNetworkMetrics(ctx context.Context, m MetricValues, _ *metri
 	connStats := globalConnStats.toServerConnStats()
 	m.Set(apiTrafficSentBytes, float64(connStats.s3OutputBytes), "type", "s3")
 	m.Set(apiTrafficRecvBytes, float64(connStats.s3InputBytes), "type", "s3")
+
+	if httpServer := newHTTPServerFn(); httpServer != nil {
+		m.Set(apiOpenConnectionsTotal, float64(httpServer.GetOpenConnectionCount()))
+	}
+
+	rx, tx := globalActiveRequests.inFlightBytes()
+	m.Set(apiTrafficRecvBytesInFlight, float64(rx), "type", "s3")
+	m.Set(apiTrafficSentBytesInFlight, float64(tx), "type", "s3")
 	return nil
 }
 
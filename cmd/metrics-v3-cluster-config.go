@@ -22,6 +22,8 @@ import "context"
 const (
 	configRRSParity      = "rrs_parity"
 	configStandardParity = "standard_parity"
+	configInlineBlock    = "inline_block_bytes"
+	configBlockSize      = "block_size_bytes"
 )
 
 var (
@@ -29,6 +31,10 @@ var (
 		"Reduced redundancy storage class parity")
 	configStandardParityMD = NewGaugeMD(configStandardParity,
 		"Standard storage class parity")
+	configInlineBlockMD = NewGaugeMD(configInlineBlock,
+		"Shard size, in bytes, up to which object data is inlined alongside its metadata")
+	configBlockSizeMD = NewGaugeMD(configBlockSize,
+		"Erasure stripe size, in bytes, used when splitting object data into shards")
 )
 
 // loadClusterConfigMetrics - `MetricsLoaderFn` for cluster config
@@ -42,5 +48,8 @@ func loadClusterConfigMetrics(ctx context.Context, m MetricValues, c *metricsCac
 		m.Set(configRRSParity, float64(clusterDriveMetrics.storageInfo.Backend.RRSCParity))
 	}
 
+	m.Set(configInlineBlock, float64(globalStorageClass.InlineBlock()))
+	m.Set(configBlockSize, float64(globalStorageClass.BlockSize()))
+
 	return nil
 }
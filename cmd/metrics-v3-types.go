@@ -465,8 +465,22 @@ func (mg *MetricsGroup) Collect(ch chan<- prometheus.Metric) {
 	// normally not happen, and usually indicates a bug.
 	logger.CriticalIf(GlobalContext, errors.Wrap(err, "failed to get metrics"))
 
+	// The deployment ID is added to every metric as a constant label, so
+	// that fleets of clusters scraped into one central store can be told
+	// apart. It is read fresh on every collection (rather than baked in at
+	// startup via ExtraLabels) because the deployment ID is only known once
+	// the backend is initialized, which happens after metric groups are
+	// constructed. A local copy of the label map is used to avoid mutating
+	// the shared `mg.ExtraLabels` map, since Collect can run concurrently
+	// with other scrapes.
+	extraLabels := make(map[string]string, len(mg.ExtraLabels)+1)
+	for k, v := range mg.ExtraLabels {
+		extraLabels[k] = v
+	}
+	extraLabels[deploymentID] = globalDeploymentID()
+
 	promMetrics := metricValues.ToPromMetrics(mg.CollectorPath.metricPrefix(),
-		mg.ExtraLabels)
+		extraLabels)
 	for _, metric := range promMetrics {
 		ch <- metric
 	}
@@ -87,6 +87,8 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 
 			apiRequestsWaitingTotalMD,
 			apiRequestsIncomingTotalMD,
+			apiRequestsMaxConcurrentMD,
+			apiRequestsPoolInUseMD,
 			apiRequestsInFlightTotalMD,
 			apiRequestsTotalMD,
 			apiRequestsErrorsTotalMD,
@@ -98,6 +100,9 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 
 			apiTrafficSentBytesMD,
 			apiTrafficRecvBytesMD,
+			apiOpenConnectionsTotalMD,
+			apiTrafficSentBytesInFlightMD,
+			apiTrafficRecvBytesInFlightMD,
 		},
 		JoinLoaders(loadAPIRequestsHTTPMetrics, loadAPIRequestsTTFBMetrics,
 			loadAPIRequestsNetworkMetrics),
@@ -350,6 +355,8 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 		[]MetricDescriptor{
 			configRRSParityMD,
 			configStandardParityMD,
+			configInlineBlockMD,
+			configBlockSizeMD,
 		},
 		loadClusterConfigMetrics,
 	)
@@ -427,6 +434,9 @@ func newMetricGroups(r *prometheus.Registry) *metricsV3Collection {
 	// map for bucket metrics and handle them specially.
 
 	// Add the serverName and poolIndex labels to all non-cluster metrics.
+	// The deploymentID label is added to every group (cluster and
+	// non-cluster alike) dynamically in MetricsGroup.Collect, since it is
+	// not yet known at this point during startup (see its doc comment).
 	//
 	// Also create metric group maps and set the cache.
 	metricsCache := newMetricsCache()
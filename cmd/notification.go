@@ -38,6 +38,7 @@ import (
 	"github.com/minio/pkg/v3/sync/errgroup"
 	"github.com/minio/pkg/v3/workers"
 
+	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/bucket/bandwidth"
 	"github.com/minio/minio/internal/logger"
 )
@@ -447,6 +448,22 @@ func (sys *NotificationSys) SignalConfigReload(subSys string) []NotificationPeer
 	return ng.Wait()
 }
 
+// RotateRootCredentials - asks all peers to accept newCred as the active
+// root credential, while still accepting oldCred until graceExpiry.
+func (sys *NotificationSys) RotateRootCredentials(ctx context.Context, newCred, oldCred auth.Credentials, graceExpiry time.Time) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.RotateRootCredentials(ctx, newCred, oldCred, graceExpiry)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
 // SignalService - calls signal service RPC call on all peers.
 func (sys *NotificationSys) SignalService(sig serviceSignal) []NotificationPeerErr {
 	ng := WithNPeers(len(sys.peerClients))
@@ -1155,6 +1172,36 @@ func (sys *NotificationSys) ServerInfo(ctx context.Context, metrics bool) []madm
 	return reply
 }
 
+// NetworkThroughput - calls GetNetworkThroughput RPC call on all peers, and
+// adds the local node's counters, to report per-node network throughput
+// across the cluster.
+func (sys *NotificationSys) NetworkThroughput(ctx context.Context) []NetworkThroughputInfo {
+	reply := make([]NetworkThroughputInfo, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for i, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(client *peerRESTClient, idx int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			info, err := client.GetNetworkThroughput(ctx)
+			if err != nil {
+				info.Addr = client.host.String()
+				info.Error = err.Error()
+			}
+			reply[idx] = info
+		}(client, i)
+	}
+	wg.Wait()
+
+	reply = append(reply, getLocalNetworkThroughput())
+
+	return reply
+}
+
 // restClientFromHash will return a deterministic peerRESTClient based on s.
 // Will return nil if client is local.
 func (sys *NotificationSys) restClientFromHash(s string) (client *peerRESTClient) {
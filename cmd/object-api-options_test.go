@@ -77,3 +77,66 @@ func TestGetAndValidateAttributesOpts(t *testing.T) {
 		})
 	}
 }
+
+// TestGetAndValidateAttributesOptsValidation covers the validation half of
+// getAndValidateAttributesOpts(): a missing/unknown x-amz-object-attributes
+// entry is rejected, a recognized one is accepted, and MaxParts defaults to
+// maxPartsList when the header is absent.
+func TestGetAndValidateAttributesOptsValidation(t *testing.T) {
+	globalBucketVersioningSys = &BucketVersioningSys{}
+	bucket := minioMetaBucket
+	ctx := context.Background()
+
+	testCases := []struct {
+		name         string
+		headers      http.Header
+		wantValid    bool
+		wantMaxParts int
+	}{
+		{
+			name:      "no attributes requested",
+			headers:   http.Header{},
+			wantValid: false,
+		},
+		{
+			name: "unknown attribute name",
+			headers: http.Header{
+				xhttp.AmzObjectAttributes: []string{"NotAnAttribute"},
+			},
+			wantValid: false,
+		},
+		{
+			name: "known attributes default max parts",
+			headers: http.Header{
+				xhttp.AmzObjectAttributes: []string{"ETag,Checksum,StorageClass,ObjectSize,ObjectParts"},
+			},
+			wantValid:    true,
+			wantMaxParts: maxPartsList,
+		},
+		{
+			name: "known attribute with explicit max parts",
+			headers: http.Header{
+				xhttp.AmzObjectAttributes: []string{"ETag"},
+				xhttp.AmzMaxParts:         []string{"5"},
+			},
+			wantValid:    true,
+			wantMaxParts: 5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header = testCase.headers
+
+			opts, valid := getAndValidateAttributesOpts(ctx, rec, req, bucket, "testobject")
+			if valid != testCase.wantValid {
+				t.Fatalf("want valid %v, got %v", testCase.wantValid, valid)
+			}
+			if testCase.wantValid && opts.MaxParts != testCase.wantMaxParts {
+				t.Errorf("want MaxParts %d, got %d", testCase.wantMaxParts, opts.MaxParts)
+			}
+		})
+	}
+}
@@ -342,6 +342,49 @@ func TestIsMinioMetaBucketName(t *testing.T) {
 	}
 }
 
+// Tests hasSpaceFor, the pre-flight capacity check used to fail PUTs fast
+// with StorageFull instead of running out of space midway through a write.
+func TestHasSpaceFor(t *testing.T) {
+	disk := func(total, used uint64) *DiskInfo {
+		return &DiskInfo{Total: total, Free: total - used, Used: used, FreeInodes: 1e6}
+	}
+
+	tests := []struct {
+		name string
+		di   []*DiskInfo
+		size int64
+		want bool
+	}{
+		{
+			name: "plenty of space",
+			di:   []*DiskInfo{disk(1000, 100), disk(1000, 100)},
+			size: 10,
+			want: true,
+		},
+		{
+			name: "not enough space once parity overhead is accounted for",
+			di:   []*DiskInfo{disk(1000, 100), disk(1000, 100)},
+			size: 1000,
+			want: false,
+		},
+		{
+			name: "majority of disks offline",
+			di:   []*DiskInfo{disk(1000, 100), nil, nil, nil},
+			size: 10,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		got, err := hasSpaceFor(tt.di, tt.size)
+		if tt.want && err != nil {
+			t.Errorf("Test %s: unexpected error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("Test %s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
 // Tests RemoveStandardStorageClass method. Expectation is metadata map
 // should be cleared of x-amz-storage-class, if it is set to STANDARD
 func TestRemoveStandardStorageClass(t *testing.T) {
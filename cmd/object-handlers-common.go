@@ -142,17 +142,14 @@ func checkPreconditionsPUT(ctx context.Context, w http.ResponseWriter, r *http.R
 	if r.Method != http.MethodPut && r.Method != http.MethodPost {
 		return false
 	}
-	// If the object doesn't have a modtime (IsZero), or the modtime
-	// is obviously garbage (Unix time == 0), then ignore modtimes
-	// and don't process the If-Modified-Since header.
-	if objInfo.ModTime.IsZero() || objInfo.ModTime.Equal(time.Unix(0, 0)) {
-		return false
-	}
 
-	// If top level is a delete marker proceed to upload.
-	if objInfo.DeleteMarker {
-		return false
-	}
+	// Unlike checkPreconditions (GET/HEAD), this function does not process
+	// If-Modified-Since so a zero/garbage ModTime is not a reason to skip
+	// evaluation here - in particular, objInfo is intentionally the zero
+	// value (ModTime.IsZero(), ETag == "", DeleteMarker == false) when the
+	// destination object or version does not exist yet, and If-Match must
+	// still fail precondition evaluation against that absent object instead
+	// of silently allowing the write to proceed.
 
 	// Headers to be set of object content is not going to be written to the client.
 	writeHeaders := func() {
@@ -168,10 +165,11 @@ func checkPreconditionsPUT(ctx context.Context, w http.ResponseWriter, r *http.R
 	}
 
 	// If-Match : Return the object only if its entity tag (ETag) is the same as the one specified;
-	// otherwise return a 412 (precondition failed).
+	// otherwise return a 412 (precondition failed). An empty objInfo.ETag means the destination
+	// does not exist yet, so there is nothing to match - including against the "*" wildcard.
 	ifMatchETagHeader := r.Header.Get(xhttp.IfMatch)
 	if ifMatchETagHeader != "" {
-		if !isETagEqual(objInfo.ETag, ifMatchETagHeader) {
+		if objInfo.ETag == "" || !isETagEqual(objInfo.ETag, ifMatchETagHeader) {
 			// If the object ETag does not match with the specified ETag.
 			writeHeaders()
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrPreconditionFailed), r.URL)
@@ -180,10 +178,12 @@ func checkPreconditionsPUT(ctx context.Context, w http.ResponseWriter, r *http.R
 	}
 
 	// If-None-Match : Return the object only if its entity tag (ETag) is different from the
-	// one specified otherwise, return a 304 (not modified).
+	// one specified otherwise, return a 412 (precondition failed). An empty objInfo.ETag means the
+	// destination does not exist yet, so the "*" wildcard (commonly used for create-if-absent)
+	// must not be treated as a collision.
 	ifNoneMatchETagHeader := r.Header.Get(xhttp.IfNoneMatch)
 	if ifNoneMatchETagHeader != "" {
-		if isETagEqual(objInfo.ETag, ifNoneMatchETagHeader) {
+		if objInfo.ETag != "" && isETagEqual(objInfo.ETag, ifNoneMatchETagHeader) {
 			// If the object ETag matches with the specified ETag.
 			writeHeaders()
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrPreconditionFailed), r.URL)
@@ -99,6 +99,19 @@ func TestCheckPreconditions(t *testing.T) {
 			expectedFlag:    true,
 			expectedCode:    304,
 		},
+		// If-Modified-Since must still report "not modified" when the object's
+		// ModTime carries sub-second precision that the second-resolution
+		// If-Modified-Since header (echoing back a truncated Last-Modified)
+		// cannot represent, otherwise every client that round-trips the
+		// Last-Modified header it was given would always see a false "modified".
+		{
+			name:            "If-Modified-Since-SubSecond",
+			ifNoneMatch:     "aaa",
+			ifModifiedSince: "Sun, 26 Aug 2024 02:01:01 GMT",
+			objInfo:         ObjectInfo{ETag: "aa", ModTime: objModTime.Add(999 * time.Millisecond)},
+			expectedFlag:    true,
+			expectedCode:    304,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -161,6 +174,16 @@ func TestCheckPreconditions(t *testing.T) {
 			expectedFlag: false,
 			expectedCode: 200,
 		},
+		// If-Unmodified-Since must not report "modified" on its own just
+		// because the object's ModTime has a sub-second component beyond
+		// what the second-resolution header can carry.
+		{
+			name:              "If-Unmodified-Since-SubSecond",
+			ifUnmodifiedSince: "Sun, 26 Aug 2024 02:01:01 GMT",
+			objInfo:           ObjectInfo{ETag: "aa", ModTime: objModTime.Add(999 * time.Millisecond)},
+			expectedFlag:      false,
+			expectedCode:      200,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -180,3 +203,60 @@ func TestCheckPreconditions(t *testing.T) {
 		})
 	}
 }
+
+// Tests - checkPreconditionsPUT() for conditional writes (If-Match/If-None-Match)
+func TestCheckPreconditionsPUT(t *testing.T) {
+	existing := ObjectInfo{ETag: "aa", ModTime: time.Date(2024, time.August, 26, 0o2, 0o1, 0o1, 0, time.UTC)}
+	testCases := []struct {
+		name         string
+		ifMatch      string
+		ifNoneMatch  string
+		objInfo      ObjectInfo
+		expectedFlag bool
+	}{
+		// If-Match against an object that does not exist yet (the zero
+		// ObjectInfo getObjectInfo leaves behind on errFileNotFound) must
+		// fail the precondition - there is nothing for the ETag to match.
+		{
+			name:         "If-Match-on-absent-object",
+			ifMatch:      "aa",
+			objInfo:      ObjectInfo{},
+			expectedFlag: true,
+		},
+		// If-None-Match: * against an absent object must succeed (proceed
+		// with the create), since nothing exists to collide with.
+		{
+			name:         "If-None-Match-star-on-absent-object",
+			ifNoneMatch:  "*",
+			objInfo:      ObjectInfo{},
+			expectedFlag: false,
+		},
+		// If-None-Match: * against an existing object must fail.
+		{
+			name:         "If-None-Match-star-on-existing-object",
+			ifNoneMatch:  "*",
+			objInfo:      existing,
+			expectedFlag: true,
+		},
+		// If-Match against an existing object with the matching ETag
+		// must succeed.
+		{
+			name:         "If-Match-on-existing-object",
+			ifMatch:      "aa",
+			objInfo:      existing,
+			expectedFlag: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPut, "/bucket/a", bytes.NewReader([]byte{}))
+			request.Header.Set(xhttp.IfMatch, tc.ifMatch)
+			request.Header.Set(xhttp.IfNoneMatch, tc.ifNoneMatch)
+			actualFlag := checkPreconditionsPUT(context.Background(), recorder, request, tc.objInfo, ObjectOptions{})
+			if tc.expectedFlag != actualFlag {
+				t.Errorf("test: %s, got flag: %v, want: %v", tc.name, actualFlag, tc.expectedFlag)
+			}
+		})
+	}
+}
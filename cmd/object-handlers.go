@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/textproto"
@@ -39,12 +40,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/klauspost/compress/gzhttp"
+	"github.com/minio/madmin-go/v3"
 	miniogo "github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/amztime"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/bucket/bandwidth"
 	sse "github.com/minio/minio/internal/bucket/encryption"
 	"github.com/minio/minio/internal/bucket/lifecycle"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
@@ -195,17 +198,33 @@ func (api objectAPIHandlers) SelectObjectContentHandler(w http.ResponseWriter, r
 
 	gopts := opts
 	gopts.NoLock = true // We already have a lock, we can live with it.
-	objInfo, err := getObjectInfo(ctx, bucket, object, gopts)
+
+	// Open the object once up front via GetObjectNInfo rather than stat'ing
+	// it with a separate GetObjectInfo call first; the opened reader is
+	// reused below for the first (offset 0) read instead of being
+	// discarded, avoiding a redundant stat+read round trip for the common
+	// case where s3select never needs to seek.
+	gr, err := getObjectNInfo(ctx, bucket, object, nil, r.Header, gopts)
 	if err != nil {
 		// Versioning enabled quite possibly object is deleted might be delete-marker
 		// if present set the headers, no idea why AWS S3 sets these headers.
-		if objInfo.VersionID != "" && objInfo.DeleteMarker {
-			w.Header()[xhttp.AmzVersionID] = []string{objInfo.VersionID}
-			w.Header()[xhttp.AmzDeleteMarker] = []string{strconv.FormatBool(objInfo.DeleteMarker)}
+		if gr != nil && gr.ObjInfo.VersionID != "" && gr.ObjInfo.DeleteMarker {
+			w.Header()[xhttp.AmzVersionID] = []string{gr.ObjInfo.VersionID}
+			w.Header()[xhttp.AmzDeleteMarker] = []string{strconv.FormatBool(gr.ObjInfo.DeleteMarker)}
 		}
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
+	objInfo := gr.ObjInfo
+	// Closed by the segment reader below once claimed for the offset 0
+	// read; if that never happens (e.g. s3select errors out before
+	// reading), this defer closes it instead.
+	grClaimed := false
+	defer func() {
+		if !grClaimed {
+			gr.Close()
+		}
+	}()
 
 	// filter object lock metadata if permission does not permit
 	getRetPerms := checkRequestAuthType(ctx, r, policy.GetObjectRetentionAction, bucket, object)
@@ -227,6 +246,10 @@ func (api objectAPIHandlers) SelectObjectContentHandler(w http.ResponseWriter, r
 
 	objectRSC := s3select.NewObjectReadSeekCloser(
 		func(offset int64) (io.ReadCloser, error) {
+			if offset == 0 && !grClaimed {
+				grClaimed = true
+				return gr, nil
+			}
 			rs := &HTTPRangeSpec{
 				IsSuffixLength: false,
 				Start:          offset,
@@ -304,7 +327,7 @@ func (api objectAPIHandlers) SelectObjectContentHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -316,6 +339,18 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		return
 	}
 
+	// Serve the configured index document for directory-style website
+	// requests. Access is still subject to the bucket's normal policy,
+	// this only rewrites which object is requested.
+	if websiteCfg, werr := getBucketWebsiteConfig(ctx, objectAPI, bucket); werr == nil && websiteCfg.Enabled &&
+		(object == "" || strings.HasSuffix(object, SlashSeparator)) {
+		object = pathJoin(object, websiteCfg.IndexDocument)
+		// authorizeRequest evaluates policy against reqInfo.ObjectName.
+		if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+			reqInfo.ObjectName = object
+		}
+	}
+
 	opts, err := getOpts(ctx, r, bucket, object)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -352,6 +387,9 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 					s3Error = ErrNoSuchKey
 				}
 			}
+			if s3Error == ErrNoSuchKey && serveBucketWebsiteErrorDocument(ctx, objectAPI, bucket, w, r) {
+				return
+			}
 		}
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
 		return
@@ -548,7 +586,15 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 	}
 
 	// Write object content to response body
-	if _, err = xioutil.Copy(httpWriter, gr); err != nil {
+	var respBody io.Reader = gr
+	if globalBucketMonitor.IsThrottled(bucket, "") {
+		// A bucket quota rate limit is configured; throttle egress to it,
+		// the same way replication traffic is throttled per target.
+		respBody = bandwidth.NewMonitoredReader(ctx, globalBucketMonitor, gr, &bandwidth.MonitorReaderOptions{
+			BucketOptions: bandwidth.BucketOptions{Name: bucket},
+		})
+	}
+	if _, err = xioutil.Copy(httpWriter, respBody); err != nil {
 		if !httpWriter.HasWritten() && !statusCodeWritten {
 			// write error response only if no data or headers has been written to client yet
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -571,10 +617,35 @@ func (api objectAPIHandlers) getObjectHandler(ctx context.Context, objectAPI Obj
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
+
+	// Sample a configurable percentage of successful GETs for an
+	// asynchronous full heal-scan, to continuously scrub data driven by
+	// real read traffic.
+	maybeQueueVerifyReadHeal(bucket, object, objInfo.VersionID)
+}
+
+// maybeQueueVerifyReadHeal samples GET requests at the rate configured via
+// `mc admin config set heal verify_read_percent=N` (MINIO_HEAL_VERIFY_READ_PERCENT)
+// and, when sampled, asynchronously queues the object for a full deep
+// heal-scan of all shards on the background healing workers. This never
+// blocks or slows down the GET response itself.
+func maybeQueueVerifyReadHeal(bucket, object, versionID string) {
+	pct := globalHealConfig.GetVerifyReadPercent()
+	if pct <= 0 {
+		return
+	}
+	if pct < 100 && rand.Float64()*100 >= pct {
+		return
+	}
+	go func() {
+		if err := healObject(bucket, object, versionID, madmin.HealDeepScan); err != nil {
+			healingLogOnceIf(context.Background(), err, bucket+object)
+		}
+	}()
 }
 
 // GetObjectAttributes ...
@@ -701,7 +772,7 @@ func (api objectAPIHandlers) getObjectAttributesHandler(ctx context.Context, obj
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -961,6 +1032,26 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 	// Set any additional requested response headers.
 	setHeadGetRespHeaders(w, r.Form)
 
+	if r.Header.Get(xhttp.MinIOShardCheck) == "true" && !proxy.Proxy {
+		// Dry-run heal reports per-drive presence without repairing
+		// anything, so this stays a cheap, read-only check suitable for a
+		// HEAD request. Best-effort: a failed check (e.g. object not
+		// healable, or already in progress elsewhere) just skips the
+		// header rather than failing the HEAD response.
+		if hr, herr := objectAPI.HealObject(ctx, bucket, object, objInfo.VersionID, madmin.HealOpts{
+			DryRun:   true,
+			ScanMode: madmin.HealNormalScan,
+		}); herr == nil {
+			var online int
+			for _, drive := range hr.Before.Drives {
+				if drive.State == madmin.DriveStateOk {
+					online++
+				}
+			}
+			w.Header().Set(xhttp.MinIOShardsOnline, fmt.Sprintf("%d/%d", online, len(hr.Before.Drives)))
+		}
+	}
+
 	// Successful response.
 	if rs != nil || opts.PartNumber > 0 {
 		w.WriteHeader(http.StatusPartialContent)
@@ -974,7 +1065,7 @@ func (api objectAPIHandlers) headObjectHandler(ctx context.Context, objectAPI Ob
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -1721,7 +1812,7 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		BucketName:   dstBucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -1903,6 +1994,14 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 	var reader io.Reader
 	reader = rd
 
+	if globalBucketMonitor.IsThrottled(bucket, "") {
+		// A bucket quota rate limit is configured; throttle ingress to it,
+		// the same way replication traffic is throttled per target.
+		reader = bandwidth.NewMonitoredReader(ctx, globalBucketMonitor, reader, &bandwidth.MonitorReaderOptions{
+			BucketOptions: bandwidth.BucketOptions{Name: bucket},
+		})
+	}
+
 	var opts ObjectOptions
 	opts, err = putOptsFromReq(ctx, r, bucket, object, metadata)
 	if err != nil {
@@ -2116,7 +2215,7 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	}
@@ -2515,6 +2614,33 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 	writeSuccessResponseHeadersOnly(w)
 }
 
+// AppendObjectHandler - PUT with the non-standard X-Minio-Append-Object
+// extension header, intended to let log-shipping style workloads append to
+// an existing object without a client-side read-modify-write cycle.
+//
+// This currently only recognizes the extension and reports it as not
+// implemented: every object version is stored as one or more fixed-size,
+// bitrot-hashed parts keyed off the total object size (see PutObject and
+// erasure-encode.go), and there is no on-disk representation today for
+// "add N more bytes to the last part of an existing version" that preserves
+// those bitrot hashes without rewriting the object - doing that safely
+// needs a dedicated append-aware part/version layout, which is beyond a
+// single incremental change. Reporting ErrNotImplemented here at least lets
+// clients detect the lack of support explicitly, instead of getting back
+// whatever PutObject would otherwise have done with the same headers.
+func (api objectAPIHandlers) AppendObjectHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "AppendObject")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+}
+
 // Delete objectAPIHandlers
 
 // DeleteObjectHandler - delete an object
@@ -2642,7 +2768,7 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 				BucketName:   bucket,
 				Object:       objInfo,
 				ReqParams:    extractReqParams(r),
-				RespElements: extractRespElements(w),
+				RespElements: extractRespElements(w, r),
 				UserAgent:    r.UserAgent(),
 				Host:         handlers.GetSourceIP(r),
 			})
@@ -2672,7 +2798,7 @@ func (api objectAPIHandlers) DeleteObjectHandler(w http.ResponseWriter, r *http.
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -2795,7 +2921,7 @@ func (api objectAPIHandlers) PutObjectLegalHoldHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -2857,7 +2983,7 @@ func (api objectAPIHandlers) GetObjectLegalHoldHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -2963,7 +3089,7 @@ func (api objectAPIHandlers) PutObjectRetentionHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -3024,7 +3150,7 @@ func (api objectAPIHandlers) GetObjectRetentionHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -3197,7 +3323,7 @@ func (api objectAPIHandlers) PutObjectTaggingHandler(w http.ResponseWriter, r *h
 					EventName:    event.ObjectCreatedPutTagging,
 					BucketName:   bucket,
 					ReqParams:    extractReqParams(r),
-					RespElements: extractRespElements(w),
+					RespElements: extractRespElements(w, r),
 					UserAgent:    r.UserAgent(),
 					Host:         handlers.GetSourceIP(r),
 				})
@@ -3239,7 +3365,7 @@ func (api objectAPIHandlers) PutObjectTaggingHandler(w http.ResponseWriter, r *h
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -3295,7 +3421,7 @@ func (api objectAPIHandlers) DeleteObjectTaggingHandler(w http.ResponseWriter, r
 					BucketName:   bucket,
 					Object:       oi,
 					ReqParams:    extractReqParams(r),
-					RespElements: extractRespElements(w),
+					RespElements: extractRespElements(w, r),
 					UserAgent:    r.UserAgent(),
 					Host:         handlers.GetSourceIP(r),
 				})
@@ -3344,7 +3470,7 @@ func (api objectAPIHandlers) DeleteObjectTaggingHandler(w http.ResponseWriter, r
 		BucketName:   bucket,
 		Object:       oi,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	})
@@ -1072,7 +1072,7 @@ func (api objectAPIHandlers) CompleteMultipartUploadHandler(w http.ResponseWrite
 		BucketName:   bucket,
 		Object:       objInfo,
 		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
+		RespElements: extractRespElements(w, r),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	}
@@ -161,3 +161,13 @@ func globalSync() {
 	defer globalOSMetrics.time(osMetricSync)()
 	syscall.Sync()
 }
+
+// fsyncDir fsyncs the directory at dirPath.
+func fsyncDir(dirPath string) error {
+	f, err := OpenFile(dirPath, os.O_RDONLY, 0o777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
@@ -357,3 +357,16 @@ func globalSync() {
 	defer globalOSMetrics.time(osMetricSync)()
 	syscall.Sync()
 }
+
+// fsyncDir fsyncs the directory at dirPath, so that a preceding rename of
+// one of its entries is durable across a crash - on most POSIX filesystems
+// a rename is only guaranteed to survive a power loss once the containing
+// directory's metadata has itself been flushed to disk.
+func fsyncDir(dirPath string) error {
+	f, err := OpenFile(dirPath, os.O_RDONLY, 0o777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
@@ -183,6 +183,12 @@ func globalSync() {
 	// no-op on windows
 }
 
+// fsyncDir is a no-op on windows, where FlushFileBuffers on a directory
+// handle is not supported the way directory fsync is on POSIX filesystems.
+func fsyncDir(dirPath string) error {
+	return nil
+}
+
 func syscallErrToFileErr(dirPath string, err error) error {
 	switch err {
 	case nil:
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/bucket/bandwidth"
 	"github.com/minio/minio/internal/grid"
 	xhttp "github.com/minio/minio/internal/http"
@@ -184,6 +185,12 @@ func (client *peerRESTClient) GetNetInfo(ctx context.Context) (info madmin.NetIn
 	return resp.ValueOrZero(), err
 }
 
+// GetNetworkThroughput - fetch cumulative network byte counters for a remote node.
+func (client *peerRESTClient) GetNetworkThroughput(ctx context.Context) (info NetworkThroughputInfo, err error) {
+	resp, err := getNetworkThroughputRPC.Call(ctx, client.gridConn(), grid.NewMSS())
+	return resp.ValueOrZero(), err
+}
+
 // GetPartitions - fetch disk partition information for a remote node.
 func (client *peerRESTClient) GetPartitions(ctx context.Context) (info madmin.Partitions, err error) {
 	resp, err := getPartitionsRPC.Call(ctx, client.gridConn(), grid.NewMSS())
@@ -351,6 +358,19 @@ func (client *peerRESTClient) DeleteUser(ctx context.Context, accessKey string)
 	return err
 }
 
+// RotateRootCredentials - asks a peer to accept newCred as the active root
+// credential, while still accepting oldCred until graceExpiry.
+func (client *peerRESTClient) RotateRootCredentials(ctx context.Context, newCred, oldCred auth.Credentials, graceExpiry time.Time) (err error) {
+	_, err = rotateRootCredRPC.Call(ctx, client.gridConn(), grid.NewMSSWith(map[string]string{
+		peerRESTAccessKey:    newCred.AccessKey,
+		peerRESTSecretKey:    newCred.SecretKey,
+		peerRESTOldAccessKey: oldCred.AccessKey,
+		peerRESTOldSecretKey: oldCred.SecretKey,
+		peerRESTGraceExpiry:  graceExpiry.Format(time.RFC3339Nano),
+	}))
+	return err
+}
+
 // DeleteServiceAccount - delete a specific service account.
 func (client *peerRESTClient) DeleteServiceAccount(ctx context.Context, accessKey string) (err error) {
 	_, err = deleteSvcActRPC.Call(ctx, client.gridConn(), grid.NewMSSWith(map[string]string{
@@ -20,7 +20,7 @@ package cmd
 import "time"
 
 const (
-	peerRESTVersion       = "v39" // add more flags to speedtest API
+	peerRESTVersion       = "v40" // add RotateRootCred RPC
 	peerRESTVersionPrefix = SlashSeparator + peerRESTVersion
 	peerRESTPrefix        = minioReservedBucketPath + "/peer"
 	peerRESTPath          = peerRESTPrefix + peerRESTVersionPrefix
@@ -68,6 +68,10 @@ const (
 	peerRESTMetrics         = "metrics"
 	peerRESTDryRun          = "dry-run"
 	peerRESTUploadID        = "up-id"
+	peerRESTSecretKey       = "secret-key"
+	peerRESTOldAccessKey    = "old-access-key"
+	peerRESTOldSecretKey    = "old-secret-key"
+	peerRESTGraceExpiry     = "grace-expiry"
 
 	peerRESTURL         = "url"
 	peerRESTSha256Sum   = "sha256sum"
@@ -37,6 +37,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/klauspost/compress/zstd"
 	"github.com/minio/madmin-go/v3"
+	"github.com/minio/minio/internal/auth"
 	"github.com/minio/minio/internal/bucket/bandwidth"
 	b "github.com/minio/minio/internal/bucket/bandwidth"
 	"github.com/minio/minio/internal/event"
@@ -69,6 +70,7 @@ var (
 	madminSysConfig        = grid.NewJSONPool[madmin.SysConfig]()
 	madminSysErrors        = grid.NewJSONPool[madmin.SysErrors]()
 	madminSysServices      = grid.NewJSONPool[madmin.SysServices]()
+	networkThroughputInfo  = grid.NewJSONPool[NetworkThroughputInfo]()
 
 	// Request -> Response RPC calls
 	deleteBucketMetadataRPC        = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerDeleteBucketMetadata, grid.NewMSS, grid.NewNoPayload).IgnoreNilConn()
@@ -87,6 +89,7 @@ var (
 	getMetacacheListingRPC         = grid.NewSingleHandler[*listPathOptions, *metacache](grid.HandlerGetMetacacheListing, func() *listPathOptions { return &listPathOptions{} }, func() *metacache { return &metacache{} })
 	getMetricsRPC                  = grid.NewSingleHandler[*grid.URLValues, *grid.JSON[madmin.RealtimeMetrics]](grid.HandlerGetMetrics, grid.NewURLValues, madminRealtimeMetrics.NewJSON)
 	getNetInfoRPC                  = grid.NewSingleHandler[*grid.MSS, *grid.JSON[madmin.NetInfo]](grid.HandlerGetNetInfo, grid.NewMSS, madminNetInfo.NewJSON)
+	getNetworkThroughputRPC        = grid.NewSingleHandler[*grid.MSS, *grid.JSON[NetworkThroughputInfo]](grid.HandlerGetNetworkThroughput, grid.NewMSS, networkThroughputInfo.NewJSON)
 	getOSInfoRPC                   = grid.NewSingleHandler[*grid.MSS, *grid.JSON[madmin.OSInfo]](grid.HandlerGetOSInfo, grid.NewMSS, madminOSInfo.NewJSON)
 	getPartitionsRPC               = grid.NewSingleHandler[*grid.MSS, *grid.JSON[madmin.Partitions]](grid.HandlerGetPartitions, grid.NewMSS, madminPartitions.NewJSON)
 	getPeerBucketMetricsRPC        = grid.NewSingleHandler[*grid.MSS, *grid.Array[*MetricV2]](grid.HandlerGetPeerBucketMetrics, grid.NewMSS, aoMetricsGroup.New)
@@ -117,6 +120,7 @@ var (
 	stopRebalanceRPC               = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerStopRebalance, grid.NewMSS, grid.NewNoPayload)
 	updateMetacacheListingRPC      = grid.NewSingleHandler[*metacache, *metacache](grid.HandlerUpdateMetacacheListing, func() *metacache { return &metacache{} }, func() *metacache { return &metacache{} })
 	cleanupUploadIDCacheMetaRPC    = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerClearUploadID, grid.NewMSS, grid.NewNoPayload)
+	rotateRootCredRPC              = grid.NewSingleHandler[*grid.MSS, grid.NoPayload](grid.HandlerRotateRootCred, grid.NewMSS, grid.NewNoPayload).IgnoreNilConn()
 
 	// STREAMS
 	// Set an output capacity of 100 for consoleLog and listenRPC
@@ -250,6 +254,29 @@ func (s *peerRESTServer) DeleteUserHandler(mss *grid.MSS) (np grid.NoPayload, ne
 	return
 }
 
+// RotateRootCredHandler - applies a root credential rotation received from
+// the peer that originated the admin request, so this node accepts the new
+// root credential (and, during the grace window, the old one) for both S3
+// and inter-node RPC auth.
+func (s *peerRESTServer) RotateRootCredHandler(mss *grid.MSS) (np grid.NoPayload, nerr *grid.RemoteErr) {
+	graceExpiry, err := time.Parse(time.RFC3339Nano, mss.Get(peerRESTGraceExpiry))
+	if err != nil {
+		return np, grid.NewRemoteErr(err)
+	}
+
+	newCred := auth.Credentials{
+		AccessKey: mss.Get(peerRESTAccessKey),
+		SecretKey: mss.Get(peerRESTSecretKey),
+	}
+	oldCred := auth.Credentials{
+		AccessKey: mss.Get(peerRESTOldAccessKey),
+		SecretKey: mss.Get(peerRESTOldSecretKey),
+	}
+
+	applyRootCredentialRotation(newCred, oldCred, graceExpiry)
+	return
+}
+
 // LoadUserHandler - reloads a user on the server.
 func (s *peerRESTServer) LoadUserHandler(mss *grid.MSS) (np grid.NoPayload, nerr *grid.RemoteErr) {
 	objAPI := newObjectLayerFn()
@@ -391,6 +418,12 @@ func (s *peerRESTServer) GetNetInfoHandler(_ *grid.MSS) (*grid.JSON[madmin.NetIn
 	return madminNetInfo.NewJSONWith(&info), nil
 }
 
+// GetNetworkThroughputHandler - returns this node's cumulative network byte counters.
+func (s *peerRESTServer) GetNetworkThroughputHandler(_ *grid.MSS) (*grid.JSON[NetworkThroughputInfo], *grid.RemoteErr) {
+	info := getLocalNetworkThroughput()
+	return networkThroughputInfo.NewJSONWith(&info), nil
+}
+
 // GetPartitionsHandler - returns disk partition information.
 func (s *peerRESTServer) GetPartitionsHandler(_ *grid.MSS) (*grid.JSON[madmin.Partitions], *grid.RemoteErr) {
 	info := madmin.GetPartitions(context.Background(), globalLocalNodeName)
@@ -1372,6 +1405,7 @@ func registerPeerRESTHandlers(router *mux.Router, gm *grid.Manager) {
 	logger.FatalIf(deletePolicyRPC.Register(gm, server.DeletePolicyHandler), "unable to register handler")
 	logger.FatalIf(deleteSvcActRPC.Register(gm, server.DeleteServiceAccountHandler), "unable to register handler")
 	logger.FatalIf(deleteUserRPC.Register(gm, server.DeleteUserHandler), "unable to register handler")
+	logger.FatalIf(rotateRootCredRPC.Register(gm, server.RotateRootCredHandler), "unable to register handler")
 	logger.FatalIf(getAllBucketStatsRPC.Register(gm, server.GetAllBucketStatsHandler), "unable to register handler")
 	logger.FatalIf(getBackgroundHealStatusRPC.Register(gm, server.BackgroundHealStatusHandler), "unable to register handler")
 	logger.FatalIf(getBandwidthRPC.Register(gm, server.GetBandwidth), "unable to register handler")
@@ -1383,6 +1417,7 @@ func registerPeerRESTHandlers(router *mux.Router, gm *grid.Manager) {
 	logger.FatalIf(getMetacacheListingRPC.Register(gm, server.GetMetacacheListingHandler), "unable to register handler")
 	logger.FatalIf(getMetricsRPC.Register(gm, server.GetMetricsHandler), "unable to register handler")
 	logger.FatalIf(getNetInfoRPC.Register(gm, server.GetNetInfoHandler), "unable to register handler")
+	logger.FatalIf(getNetworkThroughputRPC.Register(gm, server.GetNetworkThroughputHandler), "unable to register handler")
 	logger.FatalIf(getOSInfoRPC.Register(gm, server.GetOSInfoHandler), "unable to register handler")
 	logger.FatalIf(getPartitionsRPC.Register(gm, server.GetPartitionsHandler), "unable to register handler")
 	logger.FatalIf(getPeerBucketMetricsRPC.Register(gm, server.GetPeerBucketMetrics), "unable to register handler")
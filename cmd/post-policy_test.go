@@ -130,7 +130,7 @@ func testPostPolicyReservedBucketExploit(obj ObjectLayer, instanceType string, d
 	// Register the API end points with Erasure/FS object layer.
 	apiRouter := initTestAPIEndPoints(obj, []string{"PostPolicy"})
 
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 	bucketName := minioMetaBucket
 	objectName := "config/x"
 
@@ -192,7 +192,7 @@ func testPostPolicyBucketHandler(obj ObjectLayer, instanceType string, t TestErr
 	// Register the API end points with Erasure/FS object layer.
 	apiRouter := initTestAPIEndPoints(obj, []string{"PostPolicy"})
 
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 
 	curTime := UTCNow()
 	curTimePlus5Min := curTime.Add(time.Minute * 5)
@@ -532,7 +532,7 @@ func testPostPolicyBucketHandlerRedirect(obj ObjectLayer, instanceType string, t
 	// Register the API end points with Erasure/FS object layer.
 	apiRouter := initTestAPIEndPoints(obj, []string{"PostPolicy"})
 
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 
 	curTime := UTCNow()
 	curTimePlus5Min := curTime.Add(time.Minute * 5)
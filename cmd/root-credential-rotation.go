@@ -0,0 +1,127 @@
+// Copyright (c) 2015-2026 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+// rotatedRootCredMu guards globalActiveCredStore as well as the fields
+// below. Root credential rotation is rare (an operator-triggered admin
+// call) and the active/old credential are only re-read a couple of times
+// per request, so a simple RWMutex is enough - no need for the
+// atomic-swap tricks used by hot paths.
+var (
+	rotatedRootCredMu     sync.RWMutex
+	rotatedRootOldCred    *auth.Credentials
+	rotatedRootOldExpires time.Time
+)
+
+// globalActiveCred returns the server's current root credential. Call this
+// instead of reading globalActiveCredStore directly - root credential
+// rotation mutates it while it is being read on every incoming request, so
+// an unguarded read can observe a torn value mixing a stale AccessKey with
+// a new SecretKey (or vice versa).
+func globalActiveCred() auth.Credentials {
+	rotatedRootCredMu.RLock()
+	defer rotatedRootCredMu.RUnlock()
+	return globalActiveCredStore
+}
+
+// setGlobalActiveCred sets the server's current root credential. Used both
+// at startup, before any request is served, and by root credential
+// rotation while the server is live.
+func setGlobalActiveCred(cred auth.Credentials) {
+	rotatedRootCredMu.Lock()
+	globalActiveCredStore = cred
+	rotatedRootCredMu.Unlock()
+}
+
+// applyRootCredentialRotation swaps the server's active root credential to
+// newCred, keeping oldCred valid for requests until graceExpiry. It is
+// called both by the node that receives the admin request and, via peer
+// RPC, by every other node in the cluster, so that all nodes agree on both
+// credentials for the duration of the grace window.
+//
+// Root credentials normally come from MINIO_ROOT_USER/MINIO_ROOT_PASSWORD
+// (or a KMS-derived value, see autoGenerateRootCredentials) and are never
+// persisted. A rotation applied this way is the same: it only changes the
+// in-memory value on each running node, and reverts to whatever the
+// environment specifies on the next restart. Operators rotating root
+// credentials for good must update the environment (or KMS secret) to
+// match before the next restart, the same way any other env-sourced value
+// works today.
+func applyRootCredentialRotation(newCred, oldCred auth.Credentials, graceExpiry time.Time) {
+	rotatedRootCredMu.Lock()
+	rotatedRootOldCred = &oldCred
+	rotatedRootOldExpires = graceExpiry
+	globalActiveCredStore = newCred
+	rotatedRootCredMu.Unlock()
+}
+
+// oldRootCredential returns the previous root credential if a rotation is
+// in its grace window, so that request auth can keep accepting it
+// alongside the new globalActiveCred until operators have rolled every
+// client over.
+func oldRootCredential() (auth.Credentials, bool) {
+	rotatedRootCredMu.RLock()
+	defer rotatedRootCredMu.RUnlock()
+	if rotatedRootOldCred == nil || time.Now().After(rotatedRootOldExpires) {
+		return auth.Credentials{}, false
+	}
+	return *rotatedRootOldCred, true
+}
+
+// oldRootAccessKey returns the access key of a still-valid rotated-out root
+// credential, or "" if none is active. Convenience wrapper around
+// oldRootCredential for call sites that only need the identity check.
+func oldRootAccessKey() string {
+	cred, ok := oldRootCredential()
+	if !ok {
+		return ""
+	}
+	return cred.AccessKey
+}
+
+// rotateRootCredentialCluster applies the rotation locally and asks every
+// peer to do the same, so that inter-node RPC (which also authenticates
+// with globalActiveCred) keeps working against every node immediately.
+// Peers that do not respond keep their previous root credential until they
+// come back and get the mismatch surfaced as authentication failures on
+// their RPCs - the same failure mode an operator already sees today if a
+// node is brought up with the wrong MINIO_ROOT_PASSWORD.
+func rotateRootCredentialCluster(ctx context.Context, newCred auth.Credentials, graceWindow time.Duration) error {
+	oldCred := globalActiveCred()
+	graceExpiry := time.Now().Add(graceWindow)
+
+	applyRootCredentialRotation(newCred, oldCred, graceExpiry)
+
+	if globalNotificationSys != nil {
+		for _, nerr := range globalNotificationSys.RotateRootCredentials(ctx, newCred, oldCred, graceExpiry) {
+			if nerr.Err != nil {
+				peersLogIf(ctx, nerr.Err)
+			}
+		}
+	}
+
+	return nil
+}
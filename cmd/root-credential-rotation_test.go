@@ -0,0 +1,103 @@
+// Copyright (c) 2015-2026 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+func TestApplyRootCredentialRotation(t *testing.T) {
+	oldCred, err := auth.CreateCredentials("oldaccesskey", "oldsecretkey1")
+	if err != nil {
+		t.Fatalf("unable to create credential: %s", err)
+	}
+	newCred, err := auth.CreateCredentials("newaccesskey", "newsecretkey1")
+	if err != nil {
+		t.Fatalf("unable to create credential: %s", err)
+	}
+
+	setGlobalActiveCred(oldCred)
+	graceExpiry := time.Now().Add(time.Hour)
+
+	applyRootCredentialRotation(newCred, oldCred, graceExpiry)
+
+	if got := globalActiveCred(); got.AccessKey != newCred.AccessKey || got.SecretKey != newCred.SecretKey {
+		t.Fatalf("expected active credential to be the new credential, got %v", got)
+	}
+
+	cred, ok := oldRootCredential()
+	if !ok {
+		t.Fatal("expected the old credential to still be valid within the grace window")
+	}
+	if cred.AccessKey != oldCred.AccessKey || cred.SecretKey != oldCred.SecretKey {
+		t.Fatalf("expected old credential %v, got %v", oldCred, cred)
+	}
+	if got := oldRootAccessKey(); got != oldCred.AccessKey {
+		t.Fatalf("expected old access key %s, got %s", oldCred.AccessKey, got)
+	}
+
+	// Once the grace window has expired, the old credential is no longer accepted.
+	applyRootCredentialRotation(newCred, oldCred, time.Now().Add(-time.Second))
+	if _, ok := oldRootCredential(); ok {
+		t.Fatal("expected the old credential to be rejected once its grace window has expired")
+	}
+	if got := oldRootAccessKey(); got != "" {
+		t.Fatalf("expected no old access key after grace window expiry, got %s", got)
+	}
+}
+
+// TestGlobalActiveCredConcurrentAccess exercises setGlobalActiveCred racing
+// against globalActiveCred() the way live traffic would race against an
+// in-flight root credential rotation - this is exactly the scenario that
+// used to corrupt the unguarded global under `go test -race`.
+func TestGlobalActiveCredConcurrentAccess(t *testing.T) {
+	cred, err := auth.CreateCredentials("raceaccesskey", "racesecretkey")
+	if err != nil {
+		t.Fatalf("unable to create credential: %s", err)
+	}
+	setGlobalActiveCred(cred)
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				setGlobalActiveCred(cred)
+			}
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		got := globalActiveCred()
+		if got.AccessKey != cred.AccessKey || got.SecretKey != cred.SecretKey {
+			t.Fatalf("expected consistent credential, got %v", got)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
@@ -58,6 +58,10 @@ var globalMiddlewares = []mux.MiddlewareFunc{
 	// returned early by any other middleware (but after the middleware that
 	// sets the amz request id).
 	httpTracerMiddleware,
+	// Rejects S3 API requests with a 503 while the IAM subsystem is still
+	// loading, instead of letting them be evaluated against an empty/partial
+	// IAM cache further down the chain.
+	setIAMReadinessMiddleware,
 	// Auth middleware verifies incoming authorization headers and routes them
 	// accordingly. Client receives a HTTP error for invalid/unsupported
 	// signatures.
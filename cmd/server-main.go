@@ -104,6 +104,20 @@ var ServerFlags = []cli.Flag{
 		EnvVar: "MINIO_READ_HEADER_TIMEOUT",
 		Hidden: true,
 	},
+	cli.DurationFlag{
+		Name:   "read-timeout",
+		Value:  xhttp.DefaultIdleTimeout,
+		Usage:  "read timeout is the maximum amount of time allowed to read an entire request, including the body",
+		EnvVar: "MINIO_READ_TIMEOUT",
+		Hidden: true,
+	},
+	cli.DurationFlag{
+		Name:   "write-timeout",
+		Value:  xhttp.DefaultIdleTimeout,
+		Usage:  "write timeout is the maximum amount of time allowed to write a response",
+		EnvVar: "MINIO_WRITE_TIMEOUT",
+		Hidden: true,
+	},
 	cli.DurationFlag{
 		Name:   "conn-user-timeout",
 		Usage:  "custom TCP_USER_TIMEOUT for socket buffers",
@@ -193,6 +207,12 @@ var ServerFlags = []cli.Flag{
 		EnvVar: "MINIO_LOG_PREFIX",
 		Hidden: true,
 	},
+	cli.StringFlag{
+		Name:   "iam-bootstrap",
+		Usage:  "bootstrap IAM policies, users and groups from a declarative IAM export file (see 'mc admin cluster iam export') at startup; existing entries are only created or updated, never removed",
+		EnvVar: "MINIO_IAM_BOOTSTRAP_FILE",
+		Hidden: true,
+	},
 }
 
 var serverCmd = cli.Command{
@@ -879,8 +899,8 @@ func serverMain(ctx *cli.Context) {
 			UseHandler(setCriticalErrorHandler(corsHandler(handler))).
 			UseTLSConfig(newTLSConfig(getCert)).
 			UseIdleTimeout(globalServerCtxt.IdleTimeout).
-			UseReadTimeout(globalServerCtxt.IdleTimeout).
-			UseWriteTimeout(globalServerCtxt.IdleTimeout).
+			UseReadTimeout(globalServerCtxt.ReadTimeout).
+			UseWriteTimeout(globalServerCtxt.WriteTimeout).
 			UseReadHeaderTimeout(globalServerCtxt.ReadHeaderTimeout).
 			UseBaseContext(GlobalContext).
 			UseCustomLogger(log.New(io.Discard, "", 0)). // Turn-off random logging by Go stdlib
@@ -908,6 +928,11 @@ func serverMain(ctx *cli.Context) {
 				logger.Fatal(err, "Unable to start the server")
 			}
 		})
+
+		// Keep re-checking inter-node clock drift in the background, so
+		// that drift appearing after startup is still caught and surfaced
+		// in ServerInfo.
+		go startClockDriftMonitor(GlobalContext, globalEndpoints, globalGrid.Load())
 	}
 
 	if globalEnableSyncBoot {
@@ -971,9 +996,9 @@ func serverMain(ctx *cli.Context) {
 			warnings = append(warnings, color.YellowBold("Strict AWS S3 compatible incoming PUT, POST content payload validation is turned off, caution is advised do not use in production"))
 		}
 	})
-	if globalActiveCred.Equal(auth.DefaultCredentials) {
+	if globalActiveCred().Equal(auth.DefaultCredentials) {
 		msg := fmt.Sprintf("Detected default credentials '%s', we recommend that you change these values with 'MINIO_ROOT_USER' and 'MINIO_ROOT_PASSWORD' environment variables",
-			globalActiveCred)
+			globalActiveCred())
 		warnings = append(warnings, color.YellowBold(msg))
 	}
 
@@ -983,6 +1008,13 @@ func serverMain(ctx *cli.Context) {
 			globalIAMSys.Init(GlobalContext, newObject, globalEtcdClient, globalRefreshIAMInterval)
 		})
 
+		// Reconcile policies, users, groups and policy attachments from the
+		// declarative --iam-bootstrap file, if one was given. This runs once
+		// IAM itself is initialized, and only ever creates or updates entries.
+		bootstrapTrace("applyIAMBootstrapFile", func() {
+			applyIAMBootstrapFile(GlobalContext)
+		})
+
 		// Initialize Console UI
 		if globalBrowserEnabled {
 			bootstrapTrace("initConsoleServer", func() {
@@ -999,6 +1031,12 @@ func serverMain(ctx *cli.Context) {
 			})
 		}
 
+		// Start the optional periodic push of anonymized telemetry, if an
+		// operator has opted in via MINIO_ANONYMOUS_TELEMETRY(_ENDPOINT).
+		bootstrapTrace("initTelemetry", func() {
+			initTelemetry(GlobalContext)
+		})
+
 		// if we see FTP args, start FTP if possible
 		if len(globalServerCtxt.FTP) > 0 {
 			bootstrapTrace("go startFTPServer", func() {
@@ -1133,7 +1171,7 @@ func serverMain(ctx *cli.Context) {
 	}
 	bootstrapTrace("globalMinioClient", func() {
 		globalMinioClient, err = minio.New(globalLocalNodeName, &minio.Options{
-			Creds:     credentials.NewStaticV4(globalActiveCred.AccessKey, globalActiveCred.SecretKey, ""),
+			Creds:     credentials.NewStaticV4(globalActiveCred().AccessKey, globalActiveCred().SecretKey, ""),
 			Secure:    globalIsTLS,
 			Transport: globalRemoteTargetTransport,
 			Region:    region,
@@ -272,7 +272,7 @@ func processLDAPAuthentication(key ssh.PublicKey, pass []byte, user string) (per
 	claims[ldapUserN] = user
 	claims[ldapUser] = lookupResult.NormDN
 
-	cred, err := auth.GetNewCredentialsWithMetadata(claims, globalActiveCred.SecretKey)
+	cred, err := auth.GetNewCredentialsWithMetadata(claims, globalActiveCred().SecretKey)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +78,11 @@ func handleSignals() {
 		}
 
 		if objAPI := newObjectLayerFn(); objAPI != nil {
+			if globalBucketMetadataSys != nil {
+				// Best-effort warm-start snapshot for the next startup, see
+				// BucketMetadataSys.Init.
+				shutdownLogIf(context.Background(), globalBucketMetadataSys.saveSnapshot())
+			}
 			shutdownLogIf(context.Background(), objAPI.Shutdown(context.Background()))
 		}
 
@@ -57,8 +57,8 @@ func TestDoesPresignedV2SignatureMatch(t *testing.T) {
 	now := UTCNow()
 
 	var (
-		accessKey = globalActiveCred.AccessKey
-		secretKey = globalActiveCred.SecretKey
+		accessKey = globalActiveCred().AccessKey
+		secretKey = globalActiveCred().SecretKey
 	)
 	testCases := []struct {
 		queryParams map[string]string
@@ -177,7 +177,7 @@ func TestValidateV2AuthHeader(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	accessID := globalActiveCred.AccessKey
+	accessID := globalActiveCred().AccessKey
 	testCases := []struct {
 		authString    string
 		expectedError APIErrorCode
@@ -251,7 +251,7 @@ func TestDoesPolicySignatureV2Match(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	creds := globalActiveCred
+	creds := globalActiveCred()
 	policy := "policy"
 	testCases := []struct {
 		accessKey string
@@ -146,29 +146,37 @@ func isValidRegion(reqRegion string, confRegion string) bool {
 // check if the access key is valid and recognized, additionally
 // also returns if the access key is owner/admin.
 func checkKeyValid(r *http.Request, accessKey string) (auth.Credentials, bool, APIErrorCode) {
-	cred := globalActiveCred
+	cred := globalActiveCred()
 	if cred.AccessKey != accessKey {
-		if !globalIAMSys.Initialized() {
-			// Check if server has initialized, then only proceed
-			// to check for IAM users otherwise its okay for clients
-			// to retry with 503 errors when server is coming up.
-			return auth.Credentials{}, false, ErrIAMNotInitialized
-		}
+		// A root credential rotation may still be inside its grace window;
+		// accept the previous root credential as owner too so in-flight
+		// clients (and other cluster nodes that haven't rotated yet) aren't
+		// locked out until they pick up the new one.
+		if oldCred, ok := oldRootCredential(); ok && oldCred.AccessKey == accessKey {
+			cred = oldCred
+		} else {
+			if !globalIAMSys.Initialized() {
+				// Check if server has initialized, then only proceed
+				// to check for IAM users otherwise its okay for clients
+				// to retry with 503 errors when server is coming up.
+				return auth.Credentials{}, false, ErrIAMNotInitialized
+			}
 
-		// Check if the access key is part of users credentials.
-		u, ok, err := globalIAMSys.CheckKey(r.Context(), accessKey)
-		if err != nil {
-			return auth.Credentials{}, false, ErrIAMNotInitialized
-		}
-		if !ok {
-			// Credentials could be valid but disabled - return a different
-			// error in such a scenario.
-			if u.Credentials.Status == auth.AccountOff {
-				return cred, false, ErrAccessKeyDisabled
+			// Check if the access key is part of users credentials.
+			u, ok, err := globalIAMSys.CheckKey(r.Context(), accessKey)
+			if err != nil {
+				return auth.Credentials{}, false, ErrIAMNotInitialized
+			}
+			if !ok {
+				// Credentials could be valid but disabled - return a different
+				// error in such a scenario.
+				if u.Credentials.Status == auth.AccountOff {
+					return cred, false, ErrAccessKeyDisabled
+				}
+				return cred, false, ErrInvalidAccessKeyID
 			}
-			return cred, false, ErrInvalidAccessKeyID
+			cred = u.Credentials
 		}
-		cred = u.Credentials
 	}
 
 	claims, s3Err := checkClaimsFromToken(r, cred)
@@ -177,7 +185,7 @@ func checkKeyValid(r *http.Request, accessKey string) (auth.Credentials, bool, A
 	}
 	cred.Claims = claims
 
-	owner := cred.AccessKey == globalActiveCred.AccessKey || (cred.ParentUser == globalActiveCred.AccessKey && cred.AccessKey != siteReplicatorSvcAcc)
+	owner := cred.AccessKey == globalActiveCred().AccessKey || cred.AccessKey == oldRootAccessKey() || (cred.ParentUser == globalActiveCred().AccessKey && cred.AccessKey != siteReplicatorSvcAcc)
 	if owner && !globalAPIConfig.permitRootAccess() {
 		// We disable root access and its service accounts if asked for.
 		return cred, owner, ErrAccessKeyDisabled
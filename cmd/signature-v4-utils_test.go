@@ -52,11 +52,11 @@ func TestCheckValid(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = signRequestV4(req, globalActiveCred.AccessKey, globalActiveCred.SecretKey); err != nil {
+	if err = signRequestV4(req, globalActiveCred().AccessKey, globalActiveCred().SecretKey); err != nil {
 		t.Fatal(err)
 	}
 
-	_, owner, s3Err := checkKeyValid(req, globalActiveCred.AccessKey)
+	_, owner, s3Err := checkKeyValid(req, globalActiveCred().AccessKey)
 	if s3Err != ErrNone {
 		t.Fatalf("Unexpected failure with %v", errorCodes.ToAPIErr(s3Err))
 	}
@@ -29,6 +29,7 @@ import (
 	"bytes"
 	"crypto/subtle"
 	"encoding/hex"
+	"fmt"
 	"net/http"
 	"net/url"
 	"sort"
@@ -36,6 +37,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/minio/madmin-go/v3"
 	"github.com/minio/minio-go/v7/pkg/s3utils"
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/internal/auth"
@@ -43,6 +45,34 @@ import (
 	xhttp "github.com/minio/minio/internal/http"
 )
 
+// logSignatureMismatch records the canonical request and string-to-sign
+// MinIO computed for a SignatureDoesNotMatch error, to help debug exotic
+// SDKs and proxies that construct requests slightly differently than
+// MinIO expects. Only active when an operator has opted in via
+// MINIO_SIGNATURE_DEBUG; the details are never returned to the client,
+// only logged and published on the admin trace (madmin.TraceS3) channel.
+func logSignatureMismatch(r *http.Request, canonicalRequest, stringToSign string) {
+	if !globalSignatureDebugMode {
+		return
+	}
+	internalLogIf(r.Context(), fmt.Errorf("signature mismatch for %s %s\ncanonical request:\n%s\nstring to sign:\n%s",
+		r.Method, r.URL.Path, canonicalRequest, stringToSign))
+	if globalTrace.NumSubscribers(madmin.TraceS3) > 0 {
+		globalTrace.Publish(madmin.TraceInfo{
+			TraceType: madmin.TraceS3,
+			Time:      UTCNow(),
+			NodeName:  globalLocalNodeName,
+			FuncName:  "SignatureDoesNotMatch",
+			Path:      r.URL.Path,
+			Message:   "signature mismatch debug",
+			Custom: map[string]string{
+				"canonicalRequest": canonicalRequest,
+				"stringToSign":     stringToSign,
+			},
+		})
+	}
+}
+
 // AWS Signature Version '4' constants.
 const (
 	signV4Algorithm = "AWS4-HMAC-SHA256"
@@ -332,6 +362,7 @@ func doesPresignedSignatureMatch(hashedPayload string, r *http.Request, region s
 
 	// Verify signature.
 	if !compareSignatureV4(req.Form.Get(xhttp.AmzSignature), newSignature) {
+		logSignatureMismatch(r, presignedCanonicalReq, presignedStringToSign)
 		return ErrSignatureDoesNotMatch
 	}
 
@@ -400,6 +431,7 @@ func doesSignatureMatch(hashedPayload string, r *http.Request, region string, st
 
 	// Verify if signature match.
 	if !compareSignatureV4(newSignature, signV4Values.Signature) {
+		logSignatureMismatch(r, canonicalRequest, stringToSign)
 		return ErrSignatureDoesNotMatch
 	}
 
@@ -45,7 +45,7 @@ func TestDoesPolicySignatureMatch(t *testing.T) {
 
 	credentialTemplate := "%s/%s/%s/s3/aws4_request"
 	now := UTCNow()
-	accessKey := globalActiveCred.AccessKey
+	accessKey := globalActiveCred().AccessKey
 
 	testCases := []struct {
 		form     http.Header
@@ -81,7 +81,7 @@ func TestDoesPolicySignatureMatch(t *testing.T) {
 				},
 				"X-Amz-Date": []string{now.Format(iso8601Format)},
 				"X-Amz-Signature": []string{
-					getSignature(getSigningKey(globalActiveCred.SecretKey, now,
+					getSignature(getSigningKey(globalActiveCred().SecretKey, now,
 						globalMinioDefaultRegion, serviceS3), "policy"),
 				},
 				"Policy": []string{"policy"},
@@ -118,7 +118,7 @@ func TestDoesPresignedSignatureMatch(t *testing.T) {
 	credentialTemplate := "%s/%s/%s/s3/aws4_request"
 
 	region := globalSite.Region()
-	accessKeyID := globalActiveCred.AccessKey
+	accessKeyID := globalActiveCred().AccessKey
 	testCases := []struct {
 		queryParams map[string]string
 		headers     map[string]string
@@ -3905,7 +3905,7 @@ func (c *SiteReplicationSys) SiteReplicationMetaInfo(ctx context.Context, objAPI
 					continue
 				}
 
-				if v.Credentials.ParentUser != "" && v.Credentials.ParentUser == globalActiveCred.AccessKey {
+				if v.Credentials.ParentUser != "" && v.Credentials.ParentUser == globalActiveCred().AccessKey {
 					// skip all root user service accounts.
 					continue
 				}
@@ -386,7 +386,7 @@ func newFileInfo(object string, dataBlocks, parityBlocks int) (fi FileInfo) {
 		Algorithm:    erasureAlgorithm,
 		DataBlocks:   dataBlocks,
 		ParityBlocks: parityBlocks,
-		BlockSize:    blockSizeV2,
+		BlockSize:    globalStorageClass.BlockSize(),
 		Distribution: hashOrder(object, dataBlocks+parityBlocks),
 	}
 	return fi
@@ -45,6 +45,7 @@ import (
 	xjwt "github.com/minio/minio/internal/jwt"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
+	"github.com/minio/pkg/v3/env"
 	xnet "github.com/minio/pkg/v3/net"
 )
 
@@ -105,17 +106,34 @@ func (s *storageRESTServer) writeErrorResponse(w http.ResponseWriter, err error)
 	w.Write([]byte(err.Error()))
 }
 
-// DefaultSkewTime - skew time is 15 minutes between minio peers.
-const DefaultSkewTime = 15 * time.Minute
+// DefaultSkewTime - default tolerated clock drift between minio peers,
+// overridable via EnvInternodeClockSkew for clusters with a noisier NTP
+// setup.
+var DefaultSkewTime, _ = env.GetDuration(EnvInternodeClockSkew, 15*time.Minute)
+
+// EnvInternodeClockSkew overrides the tolerated clock drift between minio
+// peers used by storageServerRequestValidate (DefaultSkewTime).
+const EnvInternodeClockSkew = "_MINIO_INTERNODE_CLOCK_SKEW"
 
 // validateStorageRequestToken will validate the token against the provided audience.
 func validateStorageRequestToken(token string) error {
 	claims := xjwt.NewStandardClaims()
-	if err := xjwt.ParseWithStandardClaims(token, claims, []byte(globalActiveCred.SecretKey)); err != nil {
+	if err := xjwt.ParseWithStandardClaims(token, claims, []byte(globalActiveCred().SecretKey)); err != nil {
 		return errAuthentication
 	}
 
-	owner := claims.AccessKey == globalActiveCred.AccessKey || claims.Subject == globalActiveCred.AccessKey
+	// Pin the token to the inter-node RPC issuer/audience so a JWT minted
+	// for some other purpose (but signed with the same credentials) cannot
+	// be replayed here. Tokens minted before this pinning was added (i.e.
+	// missing both claims) are still accepted to avoid breaking a rolling
+	// upgrade mid-flight.
+	if claims.Issuer != "" || claims.Audience != "" {
+		if claims.Issuer != internodeJWTIssuer || claims.Audience != internodeJWTAudience {
+			return errAuthentication
+		}
+	}
+
+	owner := claims.AccessKey == globalActiveCred().AccessKey || claims.Subject == globalActiveCred().AccessKey
 	if !owner {
 		return errAuthentication
 	}
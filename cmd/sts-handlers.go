@@ -239,7 +239,7 @@ func parseForm(r *http.Request) error {
 
 // getTokenSigningKey returns secret key used to sign JWT session tokens
 func getTokenSigningKey() (string, error) {
-	secret := globalActiveCred.SecretKey
+	secret := globalActiveCred().SecretKey
 	if globalSiteReplicationSys.isEnabled() {
 		secretKey, err := globalSiteReplicatorCred.Get(GlobalContext)
 		if err != nil {
@@ -338,7 +338,7 @@ func (sts *stsAPIHandlers) AssumeRole(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Call hook for site replication.
-	if cred.ParentUser != globalActiveCred.AccessKey {
+	if cred.ParentUser != globalActiveCred().AccessKey {
 		replLogIf(ctx, globalSiteReplicationSys.IAMChangeHook(ctx, madmin.SRIAMItem{
 			Type: madmin.SRIAMItemSTSAcc,
 			STSCredential: &madmin.SRSTSCredential{
@@ -1017,7 +1017,7 @@ func (sts *stsAPIHandlers) AssumeRoleWithCustomToken(w http.ResponseWriter, r *h
 	}
 
 	tmpCredentials.ParentUser = parentUser
-	updatedAt, err := globalIAMSys.SetTempUser(ctx, tmpCredentials.AccessKey, tmpCredentials, "")
+	updatedAt, err := globalIAMSys.SetTempUser(ctx, tmpCredentials.AccessKey, tmpCredentials, res.Success.Policy)
 	if err != nil {
 		writeSTSErrorResponse(ctx, w, ErrSTSInternalError, err)
 		return
@@ -591,8 +591,8 @@ func (s *TestSuiteIAM) TestSTSForRoot(c *check) {
 		Client:      s.TestSuiteCommon.client,
 		STSEndpoint: s.endPoint,
 		Options: cr.STSAssumeRoleOptions{
-			AccessKey: globalActiveCred.AccessKey,
-			SecretKey: globalActiveCred.SecretKey,
+			AccessKey: globalActiveCred().AccessKey,
+			SecretKey: globalActiveCred().SecretKey,
 			Location:  "",
 		},
 	}
@@ -652,7 +652,7 @@ func (s *TestSuiteIAM) TestSTSForRoot(c *check) {
 	}
 
 	// This must fail.
-	if err := userAdmClient.AddUser(ctx, globalActiveCred.AccessKey, globalActiveCred.SecretKey); err == nil {
+	if err := userAdmClient.AddUser(ctx, globalActiveCred().AccessKey, globalActiveCred().SecretKey); err == nil {
 		c.Fatal("AddUser() for root credential must fail via root STS creds")
 	}
 }
@@ -1726,7 +1726,7 @@ func (s *TestSuiteIAM) TestLDAPSTSServiceAccounts(c *check) {
 	c.assertSvcAccDeletion(ctx, s, userAdmClient, value.AccessKeyID, bucket)
 
 	// 6. Check that service account cannot be created for some other user.
-	c.mustNotCreateSvcAccount(ctx, globalActiveCred.AccessKey, userAdmClient)
+	c.mustNotCreateSvcAccount(ctx, globalActiveCred().AccessKey, userAdmClient)
 
 	// Detach the policy from the user
 	if _, err = s.adm.DetachPolicyLDAP(ctx, userReq); err != nil {
@@ -1928,7 +1928,7 @@ func (s *TestSuiteIAM) TestLDAPSTSServiceAccountsWithGroups(c *check) {
 	c.assertSvcAccDeletion(ctx, s, userAdmClient, value.AccessKeyID, bucket)
 
 	// 6. Check that service account cannot be created for some other user.
-	c.mustNotCreateSvcAccount(ctx, globalActiveCred.AccessKey, userAdmClient)
+	c.mustNotCreateSvcAccount(ctx, globalActiveCred().AccessKey, userAdmClient)
 
 	// Detach the user policy
 	if _, err = s.adm.DetachPolicyLDAP(ctx, userReq); err != nil {
@@ -2604,7 +2604,7 @@ func (s *TestSuiteIAM) TestOpenIDServiceAcc(c *check) {
 	c.assertSvcAccDeletion(ctx, s, userAdmClient, value.AccessKeyID, bucket)
 
 	// 6. Check that service account cannot be created for some other user.
-	c.mustNotCreateSvcAccount(ctx, globalActiveCred.AccessKey, userAdmClient)
+	c.mustNotCreateSvcAccount(ctx, globalActiveCred().AccessKey, userAdmClient)
 }
 
 var testAppParams = OpenIDClientAppParams{
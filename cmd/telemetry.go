@@ -0,0 +1,151 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// telemetryStats aggregates anonymized, cluster-local usage counters.
+// Nothing here carries bucket, object, or credential identifiers; it only
+// ever holds API names (e.g. "PutObject") and object size buckets shared
+// with data usage scanning (see sizeHistogram). Collection is a no-op
+// unless globalTelemetryEnabled is set, and the aggregate is never sent
+// anywhere unless an operator also sets globalTelemetryEndpoint.
+type telemetryStats struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	apiCounts map[string]uint64
+	sizes     sizeHistogram
+}
+
+var globalTelemetry = &telemetryStats{
+	startedAt: time.Time{},
+	apiCounts: make(map[string]uint64),
+}
+
+// observe records one completed S3 API call for the telemetry snapshot.
+// Cheap no-op when telemetry collection is disabled (the common case).
+func (t *telemetryStats) observe(api string, size int64) {
+	if !globalTelemetryEnabled {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.startedAt.IsZero() {
+		t.startedAt = UTCNow()
+	}
+	if t.apiCounts == nil {
+		t.apiCounts = make(map[string]uint64)
+	}
+	t.apiCounts[api]++
+	if size >= 0 {
+		t.sizes.add(size)
+	}
+}
+
+// TelemetrySnapshot is the anonymized payload returned by the
+// branding-info-style admin endpoint and, optionally, pushed periodically
+// to globalTelemetryEndpoint.
+type TelemetrySnapshot struct {
+	DeploymentID string            `json:"deploymentID"`
+	BackendType  string            `json:"backendType"`
+	StartedAt    time.Time         `json:"startedAt"`
+	APICounts    map[string]uint64 `json:"apiCounts"`
+	ObjectSizes  map[string]uint64 `json:"objectSizeHistogram"`
+}
+
+// snapshot returns the current aggregate, along with the deployment's
+// backend type, without resetting any counters.
+func (t *telemetryStats) snapshot(ctx context.Context) TelemetrySnapshot {
+	backendType := "unknown"
+	if objAPI := newObjectLayerFn(); objAPI != nil {
+		switch BackendType(objAPI.StorageInfo(ctx, false).Backend.Type) {
+		case BackendErasure:
+			backendType = "erasure"
+		case BackendFS:
+			backendType = "fs"
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	apiCounts := make(map[string]uint64, len(t.apiCounts))
+	for api, count := range t.apiCounts {
+		apiCounts[api] = count
+	}
+	return TelemetrySnapshot{
+		DeploymentID: globalDeploymentID(),
+		BackendType:  backendType,
+		StartedAt:    t.startedAt,
+		APICounts:    apiCounts,
+		ObjectSizes:  t.sizes.toMap(),
+	}
+}
+
+// initTelemetry starts the optional periodic push of anonymized telemetry
+// snapshots to globalTelemetryEndpoint. It is a no-op unless both telemetry
+// collection and a push endpoint are explicitly configured.
+func initTelemetry(ctx context.Context) {
+	if !globalTelemetryEnabled || globalTelemetryEndpoint == "" {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(globalTelemetryFrequency)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pushTelemetry(ctx)
+			}
+		}
+	}()
+}
+
+func pushTelemetry(ctx context.Context) {
+	body, err := json.Marshal(globalTelemetry.snapshot(ctx))
+	if err != nil {
+		internalLogIf(ctx, err)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, globalTelemetryEndpoint, bytes.NewReader(body))
+	if err != nil {
+		internalLogIf(ctx, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		internalLogIf(ctx, err)
+		return
+	}
+	xhttp.DrainBody(resp.Body)
+}
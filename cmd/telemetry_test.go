@@ -0,0 +1,64 @@
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+)
+
+// Tests that telemetry collection is a strict no-op unless explicitly
+// enabled, and that once enabled it aggregates API call counts.
+func TestTelemetryObserveDisabledByDefault(t *testing.T) {
+	prevEnabled := globalTelemetryEnabled
+	defer func() { globalTelemetryEnabled = prevEnabled }()
+
+	globalTelemetryEnabled = false
+	ts := &telemetryStats{apiCounts: make(map[string]uint64)}
+	ts.observe("PutObject", 1024)
+
+	if len(ts.apiCounts) != 0 {
+		t.Fatalf("expected no counters to be recorded while disabled, got %v", ts.apiCounts)
+	}
+}
+
+func TestTelemetryObserveAggregates(t *testing.T) {
+	prevEnabled := globalTelemetryEnabled
+	defer func() { globalTelemetryEnabled = prevEnabled }()
+	globalTelemetryEnabled = true
+
+	ts := &telemetryStats{apiCounts: make(map[string]uint64)}
+	ts.observe("PutObject", 1024)
+	ts.observe("PutObject", 2048)
+	ts.observe("GetObject", 512)
+
+	if ts.apiCounts["PutObject"] != 2 {
+		t.Fatalf("expected 2 PutObject calls, got %d", ts.apiCounts["PutObject"])
+	}
+	if ts.apiCounts["GetObject"] != 1 {
+		t.Fatalf("expected 1 GetObject call, got %d", ts.apiCounts["GetObject"])
+	}
+
+	snap := ts.snapshot(context.Background())
+	if snap.APICounts["PutObject"] != 2 || snap.APICounts["GetObject"] != 1 {
+		t.Fatalf("unexpected snapshot api counts: %v", snap.APICounts)
+	}
+	if len(snap.ObjectSizes) == 0 {
+		t.Fatal("expected a non-empty object size histogram")
+	}
+}
@@ -78,10 +78,10 @@ func TestMain(m *testing.M) {
 
 	globalIsCICD = globalIsTesting
 
-	globalActiveCred = auth.Credentials{
+	setGlobalActiveCred(auth.Credentials{
 		AccessKey: auth.DefaultAccessKey,
 		SecretKey: auth.DefaultSecretKey,
-	}
+	})
 
 	globalNodeAuthToken, _ = authenticateNode(auth.DefaultAccessKey, auth.DefaultSecretKey)
 
@@ -350,7 +350,7 @@ func UnstartedTestServer(t TestErrHandler, instanceType string) TestServer {
 func initTestServerWithBackend(ctx context.Context, t TestErrHandler, testServer TestServer, objLayer ObjectLayer, disks []string) TestServer {
 	// Test Server needs to start before formatting of disks.
 	// Get credential.
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 	if !globalReplicationPool.IsSet() {
 		globalReplicationPool.Set(nil)
 	}
@@ -1329,6 +1329,27 @@ func getDeletePolicyURL(endPoint, bucketName string) string {
 	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
 }
 
+// return URL for setting bucket encryption config.
+func getPutBucketEncryptionURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("encryption", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
+// return URL for fetching bucket encryption config.
+func getGetBucketEncryptionURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("encryption", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
+// return URL for deleting bucket encryption config.
+func getDeleteBucketEncryptionURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("encryption", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
 // return URL for creating the bucket.
 func getMakeBucketURL(endPoint, bucketName string) string {
 	return makeTestTargetURL(endPoint, bucketName, "", url.Values{})
@@ -1782,7 +1803,7 @@ func ExecObjectLayerAPITest(args ExecObjectLayerAPITestArgs) {
 		args.t.Fatalf("Unable to initialize server config. %s", err)
 	}
 
-	credentials := globalActiveCred
+	credentials := globalActiveCred()
 
 	// Executing the object layer tests for single node setup.
 	args.objAPITest(objLayer, ErasureSDStr, bucketFS, fsAPIRouter, credentials, args.t)
@@ -781,6 +781,14 @@ func newContext(r *http.Request, w http.ResponseWriter, api string) context.Cont
 		VersionID:    strings.TrimSpace(r.Form.Get(xhttp.VersionID)),
 	}
 
+	// Propagate any deadline already carried by the request context (e.g.
+	// set by an internal caller) into every log line for this request, so
+	// it is visible alongside the request ID when debugging slow or
+	// canceled requests across nodes.
+	if deadline, ok := r.Context().Deadline(); ok {
+		reqInfo.AppendTags("deadline", deadline.UTC().Format(time.RFC3339))
+	}
+
 	return logger.SetReqInfo(r.Context(), reqInfo)
 }
 
@@ -948,8 +956,8 @@ func newTLSConfig(getCert certs.GetCertificateFunc) *tls.Config {
 
 	tlsConfig := &tls.Config{
 		PreferServerCipherSuites: true,
-		MinVersion:               tls.VersionTLS12,
-		NextProtos:               []string{"http/1.1", "h2"},
+		MinVersion:               tlsMinVersion(),
+		NextProtos:               tlsNextProtos(),
 		GetCertificate:           getCert,
 		ClientSessionCache:       tls.NewLRUClientSessionCache(tlsClientSessionCacheSize),
 	}
@@ -968,6 +976,27 @@ func newTLSConfig(getCert certs.GetCertificateFunc) *tls.Config {
 	return tlsConfig
 }
 
+// tlsMinVersion returns the minimum TLS version to accept, applied to both
+// the public S3/admin listener and internal RPC (grid) connections.
+// Defaults to TLS 1.2 for backward compatibility; set MINIO_API_TLS_MIN_VERSION
+// to "TLS13" to require TLS 1.3.
+func tlsMinVersion() uint16 {
+	if env.Get(api.EnvAPITLSMinVersion, "TLS12") == "TLS13" {
+		return tls.VersionTLS13
+	}
+	return tls.VersionTLS12
+}
+
+// tlsNextProtos returns the ALPN protocols offered on the public listener.
+// HTTP/2 is advertised by default; set MINIO_API_HTTP2 to "off" to disable
+// it and force HTTP/1.1, e.g. to work around a misbehaving HTTP/2 proxy.
+func tlsNextProtos() []string {
+	if env.Get(api.EnvAPIHTTP2, config.EnableOn) == config.EnableOn {
+		return []string{"http/1.1", "h2"}
+	}
+	return []string{"http/1.1"}
+}
+
 /////////// Types and functions for OpenID IAM testing
 
 // OpenIDClientAppParams - contains openID client application params, used in
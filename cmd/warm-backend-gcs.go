@@ -19,9 +19,12 @@ package cmd
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 
 	"cloud.google.com/go/storage"
 	"github.com/minio/madmin-go/v3"
@@ -32,6 +35,15 @@ import (
 	xioutil "github.com/minio/minio/internal/ioutil"
 )
 
+// gcsMinIOETagMetaKey is the object metadata key under which the MD5 MinIO
+// computed while streaming an object up to this tier is stored. This lets
+// the object's integrity be verified independently of whatever checksum GCS
+// itself recorded, which matters most for objects composed from multiple
+// parts (e.g. transitioned multipart uploads), where GCS's own MD5 for the
+// stored bytes does not necessarily match the ETag MinIO already tracks for
+// the object in its own metadata.
+const gcsMinIOETagMetaKey = "minio-etag-md5"
+
 type warmBackendGCS struct {
 	client       *storage.Client
 	Bucket       string
@@ -47,6 +59,23 @@ func (gcs *warmBackendGCS) getDest(object string) string {
 	return destObj
 }
 
+// objectAt returns a handle to the given key, pinned to the remote
+// generation rv when one is given. This is what lets Get/Remove address the
+// exact remote copy that a particular transition recorded, instead of
+// whatever happens to be the latest generation at the object's destination
+// (e.g. after it has been re-transitioned or otherwise overwritten).
+func (gcs *warmBackendGCS) objectAt(key string, rv remoteVersionID) *storage.ObjectHandle {
+	object := gcs.client.Bucket(gcs.Bucket).Object(gcs.getDest(key))
+	if rv == "" {
+		return object
+	}
+	gen, err := strconv.ParseInt(string(rv), 10, 64)
+	if err != nil {
+		return object
+	}
+	return object.Generation(gen)
+}
+
 func (gcs *warmBackendGCS) PutWithMeta(ctx context.Context, key string, data io.Reader, length int64, meta map[string]string) (remoteVersionID, error) {
 	object := gcs.client.Bucket(gcs.Bucket).Object(gcs.getDest(key))
 	w := object.NewWriter(ctx)
@@ -54,19 +83,40 @@ func (gcs *warmBackendGCS) PutWithMeta(ctx context.Context, key string, data io.
 		w.ObjectAttrs.StorageClass = gcs.StorageClass
 	}
 	w.ObjectAttrs.Metadata = meta
-	if _, err := xioutil.Copy(w, data); err != nil {
+
+	h := md5.New()
+	if _, err := xioutil.Copy(w, io.TeeReader(data, h)); err != nil {
 		return "", gcsToObjectError(err, gcs.Bucket, key)
 	}
 
-	if _, err := xioutil.Copy(w, data); err != nil {
+	if err := w.Close(); err != nil {
 		return "", gcsToObjectError(err, gcs.Bucket, key)
 	}
 
-	return "", w.Close()
+	// Record the generation of the object version just written, so that a
+	// later Get/Remove can address this exact remote copy even if the
+	// destination bucket has versioning enabled and is later overwritten.
+	rv := remoteVersionID(strconv.FormatInt(w.Attrs().Generation, 10))
+
+	// Record the MD5 computed locally as the object was streamed up, since
+	// it can't be included in the initial metadata above without buffering
+	// the whole object first. Best-effort: a failure here would otherwise
+	// look like an upload failure to the caller even though the object
+	// itself was stored successfully.
+	update := storage.ObjectAttrsToUpdate{
+		Metadata: make(map[string]string, len(meta)+1),
+	}
+	for k, v := range meta {
+		update.Metadata[k] = v
+	}
+	update.Metadata[gcsMinIOETagMetaKey] = hex.EncodeToString(h.Sum(nil))
+	if _, err := gcs.objectAt(key, rv).Update(ctx, update); err != nil {
+		tierLogIf(ctx, fmt.Errorf("unable to record MD5 metadata on %s/%s: %w", gcs.Bucket, key, err))
+	}
+
+	return rv, nil
 }
 
-// FIXME: add support for remote version ID in GCS remote tier and remove this.
-// Currently it's a no-op.
 func (gcs *warmBackendGCS) Put(ctx context.Context, key string, data io.Reader, length int64) (remoteVersionID, error) {
 	return gcs.PutWithMeta(ctx, key, data, length, map[string]string{})
 }
@@ -77,7 +127,7 @@ func (gcs *warmBackendGCS) Get(ctx context.Context, key string, rv remoteVersion
 	// Need to set `Accept-Encoding` header to `gzip` when issuing a GetObject call, to be able
 	// to download the object in compressed state.
 	// Calling ReadCompressed with true accomplishes that.
-	object := gcs.client.Bucket(gcs.Bucket).Object(gcs.getDest(key)).ReadCompressed(true)
+	object := gcs.objectAt(key, rv).ReadCompressed(true)
 
 	r, err = object.NewRangeReader(ctx, opts.startOffset, opts.length)
 	if err != nil {
@@ -87,7 +137,7 @@ func (gcs *warmBackendGCS) Get(ctx context.Context, key string, rv remoteVersion
 }
 
 func (gcs *warmBackendGCS) Remove(ctx context.Context, key string, rv remoteVersionID) error {
-	err := gcs.client.Bucket(gcs.Bucket).Object(gcs.getDest(key)).Delete(ctx)
+	err := gcs.objectAt(key, rv).Delete(ctx)
 	return gcsToObjectError(err, gcs.Bucket, key)
 }
 
@@ -810,6 +810,13 @@ func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...st
 			}
 			custom["total-errs-timeout"] = strconv.FormatUint(p.totalErrsTimeout.Load(), 10)
 			custom["total-errs-availability"] = strconv.FormatUint(p.totalErrsAvailability.Load(), 10)
+			if diskID := p.diskID.Load(); diskID != nil && *diskID != "" {
+				// The disk path in paths[0] can be reused across disk
+				// replacements, but the disk ID cannot, so include it to let
+				// --storage trace consumers attribute latency/errors to a
+				// specific physical disk even after a path is reused.
+				custom["disk-id"] = *diskID
+			}
 			globalTrace.Publish(storageTrace(s, startTime, duration, strings.Join(paths, " "), sz, errStr, custom))
 		}
 	}
@@ -1013,6 +1020,9 @@ func (p *xlStorageDiskIDCheck) monitorDiskWritable(ctx context.Context) {
 		goOffline := func(err error, spent time.Duration) {
 			if p.health.status.CompareAndSwap(diskHealthOK, diskHealthFaulty) {
 				storageLogAlwaysIf(ctx, fmt.Errorf("node(%s): taking drive %s offline: %v", globalLocalNodeName, p.storage.String(), err))
+				if mErr := globalIgnoredDisks.Mark(p.storage.Endpoint().String(), err.Error()); mErr != nil {
+					storageLogAlwaysIf(ctx, fmt.Errorf("node(%s): unable to persist ignored state for drive %s: %v", globalLocalNodeName, p.storage.String(), mErr))
+				}
 				p.health.waiting.Add(1)
 				go p.monitorDiskStatus(spent, fn)
 			}
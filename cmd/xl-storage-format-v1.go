@@ -154,6 +154,12 @@ const (
 )
 
 // DefaultBitrotAlgorithm is the default algorithm used for bitrot protection.
+// It stores a hash per erasure shard inline in the shard's file, interleaved
+// with the shard's data (see streamingBitrotReader/Writer), so reading and
+// verifying a shard is a single IO pass over exactly the bytes requested -
+// unlike the legacy whole-file algorithms listed above it, which must hash the
+// object from the start and are only kept for reading objects written by
+// older releases.
 const (
 	DefaultBitrotAlgorithm = HighwayHash256S
 )
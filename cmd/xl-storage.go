@@ -2116,6 +2116,10 @@ func (s *xlStorage) CreateFile(ctx context.Context, origvolume, volume, path str
 		return err
 	}
 
+	if err = s.checkDiskNotFull(ctx); err != nil {
+		return err
+	}
+
 	filePath := pathJoin(volumeDir, path)
 	if err = checkPathLength(filePath); err != nil {
 		return err
@@ -2135,6 +2139,30 @@ func (s *xlStorage) CreateFile(ctx context.Context, origvolume, volume, path str
 	return s.writeAllDirect(ctx, filePath, fileSize, r, os.O_CREATE|os.O_WRONLY|os.O_EXCL, volumeDir, false)
 }
 
+// checkDiskNotFull returns errDiskFull once the drive's used space is at or
+// above the configured drive.MaxUsedPercent high-water mark. Only CreateFile
+// calls it, so it applies to object data written as separate part files;
+// objects inlined into xl.meta and other metadata writes are not checked.
+// Reads and deletes never call it. Disabled (no-op) when MaxUsedPercent is 0,
+// which is the default.
+func (s *xlStorage) checkDiskNotFull(ctx context.Context) error {
+	maxUsedPercent := globalDriveConfig.GetMaxUsedPercent()
+	if maxUsedPercent <= 0 {
+		return nil
+	}
+
+	info, err := s.diskInfoCache.GetWithCtx(ctx)
+	if err != nil || info.Total == 0 {
+		return nil
+	}
+
+	if int(info.Used*100/info.Total) >= maxUsedPercent {
+		return errDiskFull
+	}
+
+	return nil
+}
+
 func (s *xlStorage) writeAllDirect(ctx context.Context, filePath string, fileSize int64, r io.Reader, flags int, skipParent string, truncate bool) (err error) {
 	if contextCanceled(ctx) {
 		return ctx.Err()
@@ -2899,7 +2927,9 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 		return res, ctx.Err()
 	}
 
-	// Commit meta-file
+	// Commit meta-file - this single rename is the atomic pointer flip that
+	// makes the new object version visible; data and any previous xl.meta
+	// backup are already durably in place on disk by this point.
 	if err = renameAll(srcFilePath, dstFilePath, skipParent); err != nil {
 		if legacyPreserved {
 			// Any failed rename calls un-roll previous transaction.
@@ -2910,6 +2940,14 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 		s.deleteFile(dstVolumeDir, dstDataPath, false, false)
 		return res, osErrToFileErr(err)
 	}
+	if s.globalSync {
+		// Fsync the destination directory so the commit rename above
+		// survives a crash immediately, instead of waiting on the next
+		// periodic filesystem writeback or the deferred global sync().
+		if derr := fsyncDir(pathutil.Dir(dstFilePath)); derr != nil {
+			storageLogOnceIf(ctx, derr, "xl-storage-rename-data-fsyncdir-"+dstVolume)
+		}
+	}
 
 	if srcVolume != minioMetaMultipartBucket {
 		// srcFilePath is some-times minioMetaTmpBucket, an attempt to
@@ -110,6 +110,19 @@ const (
 )
 
 // Credentials holds access and secret keys.
+//
+// There are intentionally no fields here for a per-credential source-IP
+// restriction, a per-credential action deny mask, or a per-credential
+// bucket count/naming limit, even though each was requested at one point
+// (as a CIDR scope, a deny-mask safety net, and a bucket quota/prefix
+// policy, respectively). All three need the same thing to be reachable: a
+// way for an admin call to set the restriction on a credential, i.e. a new
+// field on AddOrUpdateUserReq/AddServiceAccountReq/UpdateServiceAccountReq.
+// Those request types are defined in the madmin client library, which is
+// an external, versioned dependency this tree does not vendor or control,
+// so there is no way to add the field without also shipping a client
+// release - any server-side enforcement added against a field nothing can
+// ever set would be dead code from the moment it lands.
 type Credentials struct {
 	AccessKey    string                 `xml:"AccessKeyId" json:"accessKey,omitempty" yaml:"accessKey"`
 	SecretKey    string                 `xml:"SecretAccessKey" json:"secretKey,omitempty" yaml:"secretKey"`
@@ -118,16 +118,20 @@ func (m *Monitor) getReport(selectBucket SelectionFunction) *BucketBandwidthRepo
 		if !selectBucket(bucketOpts.Name) {
 			continue
 		}
+		// Report measured bandwidth for every bucket being tracked, not just
+		// ones with a throttle target, so chargeback/usage reporting works
+		// even for buckets that have no bandwidth limit configured.
+		var limit int64
 		m.tlock.RLock()
 		if tgtThrottle, ok := m.bucketsThrottle[bucketOpts]; ok {
-			currBw := bucketMeasurement.getExpMovingAvgBytesPerSecond()
-			report.BucketStats[bucketOpts] = Details{
-				LimitInBytesPerSecond:            tgtThrottle.NodeBandwidthPerSec * int64(m.NodeCount),
-				CurrentBandwidthInBytesPerSecond: currBw,
-			}
+			limit = tgtThrottle.NodeBandwidthPerSec * int64(m.NodeCount)
 		}
 		m.tlock.RUnlock()
 
+		report.BucketStats[bucketOpts] = Details{
+			LimitInBytesPerSecond:            limit,
+			CurrentBandwidthInBytesPerSecond: bucketMeasurement.getExpMovingAvgBytesPerSecond(),
+		}
 	}
 	return report
 }
@@ -126,3 +126,33 @@ func TestMonitor_GetReport(t *testing.T) {
 		})
 	}
 }
+
+// TestMonitor_GetReportUnthrottledBucket verifies that a bucket's measured
+// bandwidth is still reported even when it has no throttle target set, so
+// that usage/chargeback reporting works for buckets without a bandwidth
+// limit configured.
+func TestMonitor_GetReportUnthrottledBucket(t *testing.T) {
+	start := time.Now()
+	measurement := newBucketMeasurement(start)
+	measurement.incrementBytes(oneMiB)
+	measurement.updateExponentialMovingAverage(start.Add(1 * time.Second))
+
+	opts := BucketOptions{Name: "bucket"}
+	m := &Monitor{
+		bucketsMeasurement: map[BucketOptions]*bucketMeasurement{opts: measurement},
+		bucketsThrottle:    map[BucketOptions]*bucketThrottle{},
+		NodeCount:          1,
+	}
+
+	got := m.GetReport(SelectBuckets())
+	details, ok := got.BucketStats[opts]
+	if !ok {
+		t.Fatalf("expected a report entry for %v, got none", opts)
+	}
+	if details.LimitInBytesPerSecond != 0 {
+		t.Errorf("expected no limit for an unthrottled bucket, got %d", details.LimitInBytesPerSecond)
+	}
+	if details.CurrentBandwidthInBytesPerSecond != measurement.getExpMovingAvgBytesPerSecond() {
+		t.Errorf("expected current bandwidth %v, got %v", measurement.getExpMovingAvgBytesPerSecond(), details.CurrentBandwidthInBytesPerSecond)
+	}
+}
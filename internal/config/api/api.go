@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -51,6 +52,7 @@ const (
 	apiRootAccess                  = "root_access"
 	apiSyncEvents                  = "sync_events"
 	apiObjectMaxVersions           = "object_max_versions"
+	apiTrustedProxies              = "trusted_proxies"
 
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
@@ -59,7 +61,9 @@ const (
 	EnvAPIRemoteTransportDeadline = "MINIO_API_REMOTE_TRANSPORT_DEADLINE"
 	EnvAPITransitionWorkers       = "MINIO_API_TRANSITION_WORKERS"
 	EnvAPIListQuorum              = "MINIO_API_LIST_QUORUM"
-	EnvAPISecureCiphers           = "MINIO_API_SECURE_CIPHERS" // default config.EnableOn
+	EnvAPISecureCiphers           = "MINIO_API_SECURE_CIPHERS"  // default config.EnableOn
+	EnvAPITLSMinVersion           = "MINIO_API_TLS_MIN_VERSION" // default "TLS12", accepts "TLS12" or "TLS13"
+	EnvAPIHTTP2                   = "MINIO_API_HTTP2"           // default config.EnableOn
 	EnvAPIReplicationPriority     = "MINIO_API_REPLICATION_PRIORITY"
 	EnvAPIReplicationMaxWorkers   = "MINIO_API_REPLICATION_MAX_WORKERS"
 	EnvAPIReplicationMaxLWorkers  = "MINIO_API_REPLICATION_MAX_LRG_WORKERS"
@@ -75,6 +79,7 @@ const (
 	EnvAPISyncEvents                  = "MINIO_API_SYNC_EVENTS" // default "off"
 	EnvAPIObjectMaxVersions           = "MINIO_API_OBJECT_MAX_VERSIONS"
 	EnvAPIObjectMaxVersionsLegacy     = "_MINIO_OBJECT_MAX_VERSIONS"
+	EnvAPITrustedProxies              = "MINIO_API_TRUSTED_PROXIES"
 )
 
 // Deprecated key and ENVs
@@ -161,6 +166,11 @@ var (
 			Key:   apiObjectMaxVersions,
 			Value: "9223372036854775807",
 		},
+		config.KV{
+			Key:           apiTrustedProxies,
+			Value:         "",
+			HiddenIfEmpty: true,
+		},
 	}
 )
 
@@ -183,6 +193,7 @@ type Config struct {
 	RootAccess                  bool          `json:"root_access"`
 	SyncEvents                  bool          `json:"sync_events"`
 	ObjectMaxVersions           int64         `json:"object_max_versions"`
+	TrustedProxies              []string      `json:"trusted_proxies"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -232,6 +243,17 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	}
 	cfg.CorsAllowOrigin = corsAllowOrigin
 
+	trustedProxiesList := env.Get(EnvAPITrustedProxies, kvs.Get(apiTrustedProxies))
+	if trustedProxiesList != "" {
+		trustedProxies := strings.Split(trustedProxiesList, ",")
+		for _, proxy := range trustedProxies {
+			if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+				return cfg, fmt.Errorf("invalid trusted proxy '%s': not a CIDR or IP address", proxy)
+			}
+		}
+		cfg.TrustedProxies = trustedProxies
+	}
+
 	if err = config.CheckValidKeys(config.APISubSys, kvs, DefaultKVS, deprecatedKeys...); err != nil {
 		return cfg, err
 	}
@@ -116,5 +116,11 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         apiTrustedProxies,
+			Description: `set comma separated list of CIDRs/IPs of proxies trusted to set X-Forwarded-For, X-Real-IP and Forwarded headers; the real client IP is used in policy conditions, audit logs and API rate limiting only when the request's peer matches` + defaultHelpPostfix(apiTrustedProxies),
+			Optional:    true,
+			Type:        "csv",
+		},
 	}
 )
@@ -38,12 +38,18 @@ const (
 	browserHSTSPreload = "hsts_preload"
 	// browserReferrerPolicy setting name for Referrer-Policy response header
 	browserReferrerPolicy = "referrer_policy"
+	// browserBrandingLogoURL setting name for an operator-provided logo shown by the browser UI
+	browserBrandingLogoURL = "branding_logo_url"
+	// browserBrandingColor setting name for an operator-provided accent color shown by the browser UI
+	browserBrandingColor = "branding_color"
 
 	EnvBrowserCSPPolicy             = "MINIO_BROWSER_CONTENT_SECURITY_POLICY"
 	EnvBrowserHSTSSeconds           = "MINIO_BROWSER_HSTS_SECONDS"
 	EnvBrowserHSTSIncludeSubdomains = "MINIO_BROWSER_HSTS_INCLUDE_SUB_DOMAINS"
 	EnvBrowserHSTSPreload           = "MINIO_BROWSER_HSTS_PRELOAD"
 	EnvBrowserReferrerPolicy        = "MINIO_BROWSER_REFERRER_POLICY"
+	EnvBrowserBrandingLogoURL       = "MINIO_BROWSER_BRANDING_LOGO_URL"
+	EnvBrowserBrandingColor         = "MINIO_BROWSER_BRANDING_COLOR"
 )
 
 // DefaultKVS - default storage class config
@@ -69,6 +75,14 @@ var (
 			Key:   browserReferrerPolicy,
 			Value: "strict-origin-when-cross-origin",
 		},
+		config.KV{
+			Key:   browserBrandingLogoURL,
+			Value: "",
+		},
+		config.KV{
+			Key:   browserBrandingColor,
+			Value: "",
+		},
 	}
 )
 
@@ -82,6 +96,8 @@ type Config struct {
 	HSTSIncludeSubdomains bool   `json:"hsts_include_subdomains"`
 	HSTSPreload           bool   `json:"hsts_preload"`
 	ReferrerPolicy        string `json:"referrer_policy"`
+	BrandingLogoURL       string `json:"branding_logo_url"`
+	BrandingColor         string `json:"branding_color"`
 }
 
 // Update Updates browser with new config
@@ -93,6 +109,8 @@ func (browseCfg *Config) Update(newCfg Config) {
 	browseCfg.HSTSIncludeSubdomains = newCfg.HSTSIncludeSubdomains
 	browseCfg.HSTSPreload = newCfg.HSTSPreload
 	browseCfg.ReferrerPolicy = newCfg.ReferrerPolicy
+	browseCfg.BrandingLogoURL = newCfg.BrandingLogoURL
+	browseCfg.BrandingColor = newCfg.BrandingColor
 }
 
 // LookupConfig - lookup api config and override with valid environment settings if any.
@@ -129,6 +147,9 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		return cfg, fmt.Errorf("invalid value %v for %s", referrerPolicy, browserReferrerPolicy)
 	}
 
+	cfg.BrandingLogoURL = env.Get(EnvBrowserBrandingLogoURL, kvs.GetWithDefault(browserBrandingLogoURL, DefaultKVS))
+	cfg.BrandingColor = env.Get(EnvBrowserBrandingColor, kvs.GetWithDefault(browserBrandingColor, DefaultKVS))
+
 	return cfg, nil
 }
 
@@ -172,3 +193,17 @@ func (browseCfg *Config) GetReferPolicy() string {
 	defer configLock.RUnlock()
 	return browseCfg.ReferrerPolicy
 }
+
+// GetBrandingLogoURL - Get the operator-provided branding logo URL
+func (browseCfg *Config) GetBrandingLogoURL() string {
+	configLock.RLock()
+	defer configLock.RUnlock()
+	return browseCfg.BrandingLogoURL
+}
+
+// GetBrandingColor - Get the operator-provided branding accent color
+func (browseCfg *Config) GetBrandingColor() string {
+	configLock.RLock()
+	defer configLock.RUnlock()
+	return browseCfg.BrandingColor
+}
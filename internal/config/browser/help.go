@@ -56,5 +56,17 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         browserBrandingLogoURL,
+			Description: `set a custom logo URL shown by the browser UI` + defaultHelpPostfix(browserBrandingLogoURL),
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         browserBrandingColor,
+			Description: `set a custom accent color shown by the browser UI` + defaultHelpPostfix(browserBrandingColor),
+			Optional:    true,
+			Type:        "string",
+		},
 	}
 )
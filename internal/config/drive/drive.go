@@ -18,6 +18,8 @@
 package drive
 
 import (
+	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -30,6 +32,9 @@ const (
 	EnvMaxDriveTimeout       = "MINIO_DRIVE_MAX_TIMEOUT"
 	EnvMaxDriveTimeoutLegacy = "_MINIO_DRIVE_MAX_TIMEOUT"
 	EnvMaxDiskTimeoutLegacy  = "_MINIO_DISK_MAX_TIMEOUT"
+
+	// EnvMaxUsedPercent is the environment variable for MaxUsedPercent
+	EnvMaxUsedPercent = "MINIO_DRIVE_MAX_USED_PERCENT"
 )
 
 // DefaultKVS - default KVS for drive
@@ -38,6 +43,10 @@ var DefaultKVS = config.KVS{
 		Key:   MaxTimeout,
 		Value: "30s",
 	},
+	config.KV{
+		Key:   MaxUsedPercent,
+		Value: "0",
+	},
 }
 
 var configLk sync.RWMutex
@@ -46,6 +55,11 @@ var configLk sync.RWMutex
 type Config struct {
 	// MaxTimeout - maximum timeout for a drive operation
 	MaxTimeout time.Duration `json:"maxTimeout"`
+
+	// MaxUsedPercent - drive usage percentage at or above which new object
+	// part files are rejected with a storage full error; objects inlined into
+	// xl.meta and metadata writes are not checked. 0 disables the check.
+	MaxUsedPercent int `json:"maxUsedPercent"`
 }
 
 // Update - updates the config with latest values
@@ -53,9 +67,19 @@ func (c *Config) Update(updated Config) error {
 	configLk.Lock()
 	defer configLk.Unlock()
 	c.MaxTimeout = getMaxTimeout(updated.MaxTimeout)
+	c.MaxUsedPercent = updated.MaxUsedPercent
 	return nil
 }
 
+// GetMaxUsedPercent - returns the configured drive usage percentage at or
+// above which new object part files are rejected with a storage full error.
+// 0 means disabled.
+func (c *Config) GetMaxUsedPercent() int {
+	configLk.RLock()
+	defer configLk.RUnlock()
+	return c.MaxUsedPercent
+}
+
 // GetMaxTimeout - returns the per call drive operation timeout
 func (c *Config) GetMaxTimeout() time.Duration {
 	return c.GetOPTimeout()
@@ -90,6 +114,21 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 			cfg.MaxTimeout = getMaxTimeout(dur)
 		}
 	}
+
+	p := env.Get(EnvMaxUsedPercent, kvs.GetWithDefault(MaxUsedPercent, DefaultKVS))
+	if p == "" {
+		cfg.MaxUsedPercent = 0
+	} else {
+		pct, perr := strconv.Atoi(p)
+		if perr != nil {
+			return cfg, fmt.Errorf("invalid value for %s: %w", MaxUsedPercent, perr)
+		}
+		if pct < 0 || pct > 100 {
+			return cfg, fmt.Errorf("invalid value for %s: must be between 0 and 100", MaxUsedPercent)
+		}
+		cfg.MaxUsedPercent = pct
+	}
+
 	return cfg, err
 }
 
@@ -0,0 +1,57 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drive
+
+import (
+	"testing"
+
+	"github.com/minio/minio/internal/config"
+)
+
+func TestLookupConfigMaxUsedPercent(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected int
+		success  bool
+	}{
+		{"", 0, true},
+		{"0", 0, true},
+		{"95", 95, true},
+		{"100", 100, true},
+		{"-1", 0, false},
+		{"101", 0, false},
+		{"abc", 0, false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.value, func(t *testing.T) {
+			kvs := config.KVS{config.KV{Key: MaxUsedPercent, Value: testCase.value}}
+			cfg, err := LookupConfig(kvs)
+			if testCase.success && err != nil {
+				t.Fatalf("expected success but failed instead %s", err)
+			}
+			if !testCase.success && err == nil {
+				t.Fatal("expected failure but succeeded instead")
+			}
+			if testCase.success && cfg.GetMaxUsedPercent() != testCase.expected {
+				t.Fatalf("expected %d but got %d", testCase.expected, cfg.GetMaxUsedPercent())
+			}
+		})
+	}
+}
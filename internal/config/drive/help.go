@@ -23,6 +23,10 @@ var (
 	// MaxTimeout is the max timeout for drive
 	MaxTimeout = "max_timeout"
 
+	// MaxUsedPercent is the usage percentage at or above which a drive stops
+	// accepting new object part files
+	MaxUsedPercent = "max_used_percent"
+
 	// HelpDrive is help for drive
 	HelpDrive = config.HelpKVS{
 		config.HelpKV{
@@ -31,5 +35,11 @@ var (
 			Description: "set per call max_timeout for the drive, defaults to 30 seconds",
 			Optional:    true,
 		},
+		config.HelpKV{
+			Key:         MaxUsedPercent,
+			Type:        "number",
+			Description: "set the drive usage percentage at or above which new object part files are rejected with a storage full error (objects inlined into metadata and metadata writes are not checked), set to 0 to disable, defaults to 0",
+			Optional:    true,
+		},
 	}
 )
@@ -31,15 +31,17 @@ import (
 
 // Compression environment variables
 const (
-	Bitrot       = "bitrotscan"
-	Sleep        = "max_sleep"
-	IOCount      = "max_io"
-	DriveWorkers = "drive_workers"
-
-	EnvBitrot       = "MINIO_HEAL_BITROTSCAN"
-	EnvSleep        = "MINIO_HEAL_MAX_SLEEP"
-	EnvIOCount      = "MINIO_HEAL_MAX_IO"
-	EnvDriveWorkers = "MINIO_HEAL_DRIVE_WORKERS"
+	Bitrot            = "bitrotscan"
+	Sleep             = "max_sleep"
+	IOCount           = "max_io"
+	DriveWorkers      = "drive_workers"
+	VerifyReadPercent = "verify_read_percent"
+
+	EnvBitrot            = "MINIO_HEAL_BITROTSCAN"
+	EnvSleep             = "MINIO_HEAL_MAX_SLEEP"
+	EnvIOCount           = "MINIO_HEAL_MAX_IO"
+	EnvDriveWorkers      = "MINIO_HEAL_DRIVE_WORKERS"
+	EnvVerifyReadPercent = "MINIO_HEAL_VERIFY_READ_PERCENT"
 )
 
 var configMutex sync.RWMutex
@@ -55,6 +57,11 @@ type Config struct {
 
 	DriveWorkers int `json:"drive_workers"`
 
+	// Percentage (0-100) of GET requests for which a full, deep heal-scan
+	// of all shards is queued asynchronously, to continuously scrub data
+	// driven by real read traffic rather than waiting for a scanner cycle.
+	VerifyReadPercent float64 `json:"verify_read_percent"`
+
 	// Cached value from Bitrot field
 	cache struct {
 		// -1: bitrot enabled, 0: bitrot disabled, > 0: bitrot cycle
@@ -95,10 +102,19 @@ func (opts *Config) Update(nopts Config) {
 	opts.IOCount = nopts.IOCount
 	opts.Sleep = nopts.Sleep
 	opts.DriveWorkers = nopts.DriveWorkers
+	opts.VerifyReadPercent = nopts.VerifyReadPercent
 
 	opts.cache.bitrotCycle, _ = parseBitrotConfig(nopts.Bitrot)
 }
 
+// GetVerifyReadPercent returns the configured percentage (0-100) of GET
+// requests that should trigger an asynchronous full heal-scan.
+func (opts Config) GetVerifyReadPercent() float64 {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return opts.VerifyReadPercent
+}
+
 // DefaultKVS - default KV config for heal settings
 var DefaultKVS = config.KVS{
 	config.KV{
@@ -117,6 +133,10 @@ var DefaultKVS = config.KVS{
 		Key:   DriveWorkers,
 		Value: "",
 	},
+	config.KV{
+		Key:   VerifyReadPercent,
+		Value: "0",
+	},
 }
 
 const minimumBitrotCycleInMonths = 1
@@ -184,5 +204,13 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		cfg.DriveWorkers = -1
 	}
 
+	cfg.VerifyReadPercent, err = strconv.ParseFloat(env.Get(EnvVerifyReadPercent, kvs.GetWithDefault(VerifyReadPercent, DefaultKVS)), 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:verify_read_percent' value invalid: %w", err)
+	}
+	if cfg.VerifyReadPercent < 0 || cfg.VerifyReadPercent > 100 {
+		return cfg, errors.New("'heal:verify_read_percent' must be between 0 and 100")
+	}
+
 	return cfg, nil
 }
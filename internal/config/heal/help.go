@@ -51,5 +51,11 @@ var (
 			Optional:    true,
 			Type:        "int",
 		},
+		config.HelpKV{
+			Key:         VerifyReadPercent,
+			Description: `percentage of GET requests to sample for an asynchronous full heal-scan of all shards` + defaultHelpPostfix(VerifyReadPercent),
+			Optional:    true,
+			Type:        "number",
+		},
 	}
 )
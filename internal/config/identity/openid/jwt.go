@@ -98,6 +98,22 @@ func (r *Config) PopulatePublicKey(arn arn.ARN) error {
 	return r.pubKeys.parseAndAdd(resp.Body)
 }
 
+// RefreshJWKS - refetches the JWKS for every configured provider so that
+// key rotation at the IdP (a new `kid` being published, or an old one being
+// retired) is picked up ahead of the next AssumeRoleWithWebIdentity request,
+// rather than only on a failed Validate. Errors for individual providers are
+// returned as a combined error; callers should log and continue since the
+// previously cached keys remain usable until they are replaced.
+func (r *Config) RefreshJWKS() error {
+	var errs []error
+	for arnKey := range r.arnProviderCfgsMap {
+		if err := r.PopulatePublicKey(arnKey); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", arnKey, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ErrTokenExpired - error token expired
 var (
 	ErrTokenExpired = errors.New("token expired")
@@ -336,6 +336,12 @@ type AuthNSuccessResponse struct {
 	User               string                 `json:"user"`
 	MaxValiditySeconds int                    `json:"maxValiditySeconds"`
 	Claims             map[string]interface{} `json:"claims"`
+	// Policy, when non-empty, names an existing IAM policy to attach to the
+	// temporary credentials minted for this authentication, overriding the
+	// static policy (if any) otherwise configured for the role ARN. This
+	// lets a single role ARN be shared by identities that the plugin wants
+	// to grant different access to.
+	Policy string `json:"policy"`
 }
 
 // AuthNErrorResponse - represents an error response from the authN plugin.
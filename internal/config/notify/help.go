@@ -100,7 +100,7 @@ var (
 		},
 		config.HelpKV{
 			Key:         target.AmqpRoutingKey,
-			Description: "routing key for publishing",
+			Description: "routing key for publishing, sent as configured without placeholder substitution",
 			Optional:    true,
 			Type:        "string",
 			Sensitive:   true,
@@ -394,6 +394,18 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         target.PostgresUpsertKeyColumn,
+			Description: "column name the upsert (namespace format) `ON CONFLICT` clause targets, only needed when `table` already exists with a primary key column named differently than `key`",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.PostgresTablePartitionBy,
+			Description: "automatically create and use time-range table partitions (access format only), one of `daily` or `monthly`",
+			Optional:    true,
+			Type:        "string",
+		},
 	}
 
 	HelpMySQL = config.HelpKVS{
@@ -520,6 +532,12 @@ var (
 			Optional:    true,
 			Type:        "on|off",
 		},
+		config.HelpKV{
+			Key:         target.NATSJetStreamName,
+			Description: "JetStream stream name to publish to; created (bound to `subject`) if it does not already exist",
+			Optional:    true,
+			Type:        "string",
+		},
 		config.HelpKV{
 			Key:         target.NATSQueueDir,
 			Description: queueDirComment,
@@ -873,6 +873,11 @@ var (
 			Key:   target.NATSJetStream,
 			Value: config.EnableOff,
 		},
+		config.KV{
+			Key:           target.NATSJetStreamName,
+			Value:         "",
+			HiddenIfEmpty: true,
+		},
 		config.KV{
 			Key:           target.NATSStreaming,
 			Value:         config.EnableOff,
@@ -1011,6 +1016,11 @@ func GetNotifyNATS(natsKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 			jetStreamEnableEnv = jetStreamEnableEnv + config.Default + k
 		}
 
+		jetStreamNameEnv := target.EnvNATSJetStreamName
+		if k != config.Default {
+			jetStreamNameEnv = jetStreamNameEnv + config.Default + k
+		}
+
 		natsArgs := target.NATSArgs{
 			Enable:          true,
 			Address:         *address,
@@ -1030,6 +1040,7 @@ func GetNotifyNATS(natsKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 			RootCAs:         rootCAs,
 		}
 		natsArgs.JetStream.Enable = env.Get(jetStreamEnableEnv, kv.Get(target.NATSJetStream)) == config.EnableOn
+		natsArgs.JetStream.StreamName = env.Get(jetStreamNameEnv, kv.Get(target.NATSJetStreamName))
 
 		streamingEnableEnv := target.EnvNATSStreaming
 		if k != config.Default {
@@ -1206,6 +1217,14 @@ var (
 			Key:   target.PostgresMaxOpenConnections,
 			Value: "2",
 		},
+		config.KV{
+			Key:   target.PostgresUpsertKeyColumn,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PostgresTablePartitionBy,
+			Value: "",
+		},
 	}
 )
 
@@ -1266,6 +1285,16 @@ func GetNotifyPostgres(postgresKVS map[string]config.KVS) (map[string]target.Pos
 			return nil, cErr
 		}
 
+		upsertKeyColumnEnv := target.EnvPostgresUpsertKeyColumn
+		if k != config.Default {
+			upsertKeyColumnEnv = upsertKeyColumnEnv + config.Default + k
+		}
+
+		tablePartitionByEnv := target.EnvPostgresTablePartitionBy
+		if k != config.Default {
+			tablePartitionByEnv = tablePartitionByEnv + config.Default + k
+		}
+
 		psqlArgs := target.PostgreSQLArgs{
 			Enable:             enabled,
 			Format:             env.Get(formatEnv, kv.Get(target.PostgresFormat)),
@@ -1274,6 +1303,8 @@ func GetNotifyPostgres(postgresKVS map[string]config.KVS) (map[string]target.Pos
 			QueueDir:           env.Get(queueDirEnv, kv.Get(target.PostgresQueueDir)),
 			QueueLimit:         uint64(queueLimit),
 			MaxOpenConnections: maxOpenConnections,
+			UpsertKeyColumn:    env.Get(upsertKeyColumnEnv, kv.Get(target.PostgresUpsertKeyColumn)),
+			TablePartitionBy:   env.Get(tablePartitionByEnv, kv.Get(target.PostgresTablePartitionBy)),
 		}
 		if err = psqlArgs.Validate(); err != nil {
 			return nil, err
@@ -45,6 +45,7 @@ const (
 	ClassRRS      = "rrs"
 	Optimize      = "optimize"
 	InlineBlock   = "inline_block"
+	BlockSize     = "block_size"
 
 	// Reduced redundancy storage class environment variable
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
@@ -60,6 +61,11 @@ const (
 	// inlining means data and metadata are written
 	// together in a single file i.e xl.meta
 	InlineBlockEnv = "MINIO_STORAGE_CLASS_INLINE_BLOCK"
+	// Block size indicates the erasure stripe size used when splitting
+	// object data into shards, recorded per object in its erasure info.
+	// Larger values favor throughput on large-object workloads, smaller
+	// values favor latency on small-object workloads.
+	BlockSizeEnv = "MINIO_STORAGE_CLASS_BLOCK_SIZE"
 
 	// Supported storage class scheme is EC
 	schemePrefix = "EC"
@@ -69,6 +75,9 @@ const (
 
 	// Default RRS parity is always minimum parity.
 	defaultRRSParity = 1
+
+	// Default erasure stripe (block) size, matches the historical fixed value.
+	defaultBlockSize = 1 * humanize.MiByte
 )
 
 // DefaultKVS - default storage class config
@@ -91,6 +100,11 @@ var (
 			Value:         "",
 			HiddenIfEmpty: true,
 		},
+		config.KV{
+			Key:           BlockSize,
+			Value:         "",
+			HiddenIfEmpty: true,
+		},
 	}
 )
 
@@ -108,6 +122,7 @@ type Config struct {
 	RRS         StorageClass `json:"rrs"`
 	Optimize    string       `json:"optimize"`
 	inlineBlock int64
+	blockSize   int64
 
 	initialized bool
 }
@@ -306,6 +321,19 @@ func (sCfg *Config) InlineBlock() int64 {
 	return sCfg.inlineBlock
 }
 
+// BlockSize indicates the erasure stripe size used to split object data
+// into shards. This value is recorded per object, in its erasure info at
+// the time the object is written, so changing it never affects how
+// existing objects are read.
+func (sCfg *Config) BlockSize() int64 {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	if !sCfg.initialized || sCfg.blockSize == 0 {
+		return defaultBlockSize
+	}
+	return sCfg.blockSize
+}
+
 // CapacityOptimized - returns true if the storage-class is capacity optimized
 // meaning we will not use additional parities when drives are offline.
 //
@@ -341,6 +369,7 @@ func (sCfg *Config) Update(newCfg Config) {
 	sCfg.Standard = newCfg.Standard
 	sCfg.Optimize = newCfg.Optimize
 	sCfg.inlineBlock = newCfg.inlineBlock
+	sCfg.blockSize = newCfg.blockSize
 	sCfg.initialized = true
 }
 
@@ -425,6 +454,20 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 		cfg.inlineBlock = 128 * humanize.KiByte
 	}
 
+	blockSizeStr := env.Get(BlockSizeEnv, kvs.Get(BlockSize))
+	if blockSizeStr != "" {
+		blockSize, err := humanize.ParseBytes(blockSizeStr)
+		if err != nil {
+			return cfg, err
+		}
+		if blockSize < 64*humanize.KiByte || blockSize > 4*humanize.GiByte {
+			return cfg, config.ErrStorageClassValue(nil).Msg("block size must be between 64KiB and 4GiB, got " + blockSizeStr)
+		}
+		cfg.blockSize = int64(blockSize)
+	} else {
+		cfg.blockSize = defaultBlockSize
+	}
+
 	cfg.initialized = true
 
 	return cfg, nil
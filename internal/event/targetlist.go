@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/minio/internal/store"
@@ -34,6 +35,10 @@ const (
 
 	// The maximum allowed number of concurrent Send() calls to all configured notifications targets
 	maxConcurrentAsyncSend = 50000
+
+	// The maximum number of recent per-target delivery errors retained for
+	// health reporting purposes.
+	maxRecentTargetErrors = 10
 )
 
 // Target - event target interface
@@ -68,6 +73,15 @@ type TargetStat struct {
 	CurrentQueue     int   // Populated if target has a store.
 	TotalEvents      int64
 	FailedEvents     int64 // Number of failed events per target
+
+	LastSuccessTime time.Time     // Zero value if no event has ever been delivered successfully.
+	RecentErrors    []TargetError // Most recent delivery errors, oldest first, bounded to maxRecentTargetErrors.
+}
+
+// TargetError is a timestamped delivery error sample for a target.
+type TargetError struct {
+	Time time.Time
+	Err  string
 }
 
 // TargetList - holds list of targets indexed by target ID.
@@ -94,6 +108,10 @@ type targetStat struct {
 	totalEvents int64
 	// The number of failed events per target
 	failedEvents int64
+	// The time of the last successfully delivered event, zero if none yet.
+	lastSuccessTime time.Time
+	// Most recent delivery errors, oldest first, bounded to maxRecentTargetErrors.
+	recentErrors []TargetError
 }
 
 func (list *TargetList) getStatsByTargetID(id TargetID) (stat targetStat) {
@@ -132,7 +150,7 @@ func (list *TargetList) decCurrentSendCalls(id TargetID) {
 	return
 }
 
-func (list *TargetList) incFailedEvents(id TargetID) {
+func (list *TargetList) incFailedEvents(id TargetID, err error) {
 	list.statLock.Lock()
 	defer list.statLock.Unlock()
 
@@ -142,6 +160,24 @@ func (list *TargetList) incFailedEvents(id TargetID) {
 	}
 
 	stats.failedEvents++
+	stats.recentErrors = append(stats.recentErrors, TargetError{Time: time.Now().UTC(), Err: err.Error()})
+	if len(stats.recentErrors) > maxRecentTargetErrors {
+		stats.recentErrors = stats.recentErrors[len(stats.recentErrors)-maxRecentTargetErrors:]
+	}
+	list.targetStats[id] = stats
+	return
+}
+
+func (list *TargetList) recordSuccess(id TargetID) {
+	list.statLock.Lock()
+	defer list.statLock.Unlock()
+
+	stats, ok := list.targetStats[id]
+	if !ok {
+		stats = targetStat{}
+	}
+
+	stats.lastSuccessTime = time.Now().UTC()
 	list.targetStats[id] = stats
 	return
 }
@@ -289,10 +325,12 @@ func (list *TargetList) sendSync(event Event, targetIDset TargetIDSet) {
 
 			if err := target.Save(event); err != nil {
 				list.eventsErrorsTotal.Add(1)
-				list.incFailedEvents(id)
+				list.incFailedEvents(id, err)
 				reqInfo := &logger.ReqInfo{}
 				reqInfo.AppendTags("targetID", id.String())
 				logger.LogOnceIf(logger.SetReqInfo(context.Background(), reqInfo), logSubsys, err, id.String())
+			} else {
+				list.recordSuccess(id)
 			}
 		}(id, target)
 	}
@@ -347,6 +385,8 @@ func (list *TargetList) Stats() Stats {
 			CurrentQueue:     currentQueue,
 			FailedEvents:     stats.failedEvents,
 			TotalEvents:      stats.totalEvents,
+			LastSuccessTime:  stats.lastSuccessTime,
+			RecentErrors:     append([]TargetError(nil), stats.recentErrors...),
 		}
 	}
 
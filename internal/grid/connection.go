@@ -119,13 +119,17 @@ type Connection struct {
 	outgoingBytes func(n int64) // Record outgoing bytes.
 	trace         *tracer       // tracer for this connection.
 	baseFlags     Flags
-	outBytes      atomic.Int64
-	inBytes       atomic.Int64
-	inMessages    atomic.Int64
-	outMessages   atomic.Int64
-	reconnects    atomic.Int64
-	lastConnect   atomic.Pointer[time.Time]
-	lastPingDur   atomic.Int64
+	// canCompress is set once the remote has confirmed (during the connect
+	// handshake) that it can decode S2 compressed payloads. Messages may
+	// only be compressed towards a remote that has set this.
+	canCompress atomic.Bool
+	outBytes    atomic.Int64
+	inBytes     atomic.Int64
+	inMessages  atomic.Int64
+	outMessages atomic.Int64
+	reconnects  atomic.Int64
+	lastConnect atomic.Pointer[time.Time]
+	lastPingDur atomic.Int64
 
 	// For testing only
 	debugInConn   net.Conn
@@ -588,6 +592,9 @@ func (c *Connection) queueMsg(msg message, payload sender) error {
 			return err
 		}
 	}
+	if c.canCompress.Load() {
+		msg.compress()
+	}
 	defer PutByteBuffer(msg.Payload)
 	dst := GetByteBufferCap(msg.Msgsize())
 	dst, err := msg.MarshalMsg(dst)
@@ -683,9 +690,10 @@ func (c *Connection) connect() {
 			Op: OpConnect,
 		}
 		req := connectReq{
-			Host: c.Local,
-			ID:   c.id,
-			Time: time.Now(),
+			Host:     c.Local,
+			ID:       c.id,
+			Time:     time.Now(),
+			Compress: true,
 		}
 		req.addToken(c.authFn)
 		err = c.sendMsg(conn, m, &req)
@@ -710,6 +718,7 @@ func (c *Connection) connect() {
 			retry(fmt.Errorf("connection rejected: %s", r.RejectedReason))
 			continue
 		}
+		c.canCompress.Store(r.Compress)
 		t := time.Now().UTC()
 		c.lastConnect.Store(&t)
 		c.reconnectMu.Lock()
@@ -801,9 +810,11 @@ func (c *Connection) handleIncoming(ctx context.Context, conn net.Conn, req conn
 		Op: OpConnectResponse,
 	}
 
+	c.canCompress.Store(req.Compress)
 	resp := connectResp{
 		ID:       c.id,
 		Accepted: true,
+		Compress: true,
 	}
 	err := c.sendMsg(conn, msg, &resp)
 	if debugPrint {
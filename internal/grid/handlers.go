@@ -115,6 +115,8 @@ const (
 	HandlerCheckParts2
 	HandlerRenamePart
 	HandlerClearUploadID
+	HandlerRotateRootCred
+	HandlerGetNetworkThroughput
 
 	// Add more above here ^^^
 	// If all handlers are used, the type of Handler can be changed.
@@ -198,6 +200,8 @@ var handlerPrefixes = [handlerLast]string{
 	HandlerCheckParts2:                 storagePrefix,
 	HandlerRenamePart:                  storagePrefix,
 	HandlerClearUploadID:               peerPrefix,
+	HandlerRotateRootCred:              peerPrefix,
+	HandlerGetNetworkThroughput:        peerPrefix,
 }
 
 const (
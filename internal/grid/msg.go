@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/s2"
 	"github.com/tinylib/msgp/msgp"
 	"github.com/zeebo/xxh3"
 )
@@ -121,8 +122,18 @@ const (
 	// FlagSubroute indicates that the message has subroute.
 	// Subroute will be 32 bytes long and added before any CRC.
 	FlagSubroute
+
+	// FlagPayloadCompressed indicates that the payload is compressed
+	// with S2. Only ever set on a connection where both sides have
+	// negotiated compression support, see connectReq/connectResp.
+	FlagPayloadCompressed
 )
 
+// compressPayloadMinSize is the smallest payload that is considered for
+// S2 compression. Below this the framing overhead outweighs the benefit,
+// so it is not worth spending the CPU cycles.
+const compressPayloadMinSize = 1024
+
 // This struct cannot be changed and retain backwards compatibility.
 // If changed, endpoint version must be bumped.
 //
@@ -184,6 +195,9 @@ func (f Flags) String() string {
 	if f&FlagSubroute != 0 {
 		res = append(res, "SUB")
 	}
+	if f&FlagPayloadCompressed != 0 {
+		res = append(res, "S2")
+	}
 	return "[" + strings.Join(res, ",") + "]"
 }
 
@@ -233,9 +247,36 @@ func (m *message) parse(b []byte) (*subHandlerID, []byte, error) {
 		// Add if more modifications to h is needed
 		h = h[:len(h)-32]
 	}
+	if m.Flags&FlagPayloadCompressed != 0 {
+		decoded, err := s2.Decode(GetByteBuffer()[:0], m.Payload)
+		if err != nil {
+			return nil, nil, fmt.Errorf("s2 decode: %v", err)
+		}
+		PutByteBuffer(m.Payload)
+		m.Payload = decoded
+		m.Flags.Clear(FlagPayloadCompressed)
+	}
 	return sub, h, nil
 }
 
+// compress will compress m.Payload with S2 and set FlagPayloadCompressed
+// if it is large enough to be worth it and doing so actually shrinks it.
+// The original m.Payload is left untouched (callers may own it, e.g. a
+// caller-supplied request payload, and are free to reuse it afterwards).
+func (m *message) compress() {
+	if len(m.Payload) < compressPayloadMinSize {
+		return
+	}
+	dst := GetByteBufferCap(s2.MaxEncodedLen(len(m.Payload)))
+	encoded := s2.Encode(dst, m.Payload)
+	if len(encoded) >= len(m.Payload) {
+		PutByteBuffer(encoded)
+		return
+	}
+	m.Payload = encoded
+	m.Flags.Set(FlagPayloadCompressed)
+}
+
 // setZeroPayloadFlag will clear or set the FlagPayloadIsZero if
 // m.Payload is length 0, but not nil.
 func (m *message) setZeroPayloadFlag() {
@@ -260,6 +301,11 @@ type connectReq struct {
 	Host  string
 	Time  time.Time
 	Token string
+
+	// Compress indicates that the sender is able to decode S2 compressed
+	// payloads. Older peers will leave this unset, so compression must
+	// only be used towards a peer that has advertised support for it.
+	Compress bool
 }
 
 // addToken will add the token to the connect request.
@@ -275,6 +321,10 @@ type connectResp struct {
 	ID             [16]byte
 	Accepted       bool
 	RejectedReason string
+
+	// Compress indicates that the sender is able to decode S2 compressed
+	// payloads, see connectReq.Compress.
+	Compress bool
 }
 
 func (connectResp) Op() Op {
@@ -154,6 +154,9 @@ func (m *muxClient) sendLocked(msg message) error {
 		fmt.Println("Client sending", &msg, "to", m.parent.Remote)
 	}
 	m.SendSeq++
+	if m.parent.canCompress.Load() {
+		msg.compress()
+	}
 
 	dst, err := msg.MarshalMsg(dst)
 	if err != nil {
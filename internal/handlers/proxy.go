@@ -22,10 +22,12 @@
 package handlers
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 var (
@@ -79,9 +81,79 @@ func GetSourceScheme(r *http.Request) string {
 	return scheme
 }
 
+var (
+	trustedProxiesMu sync.RWMutex
+	trustedProxies   []*net.IPNet
+)
+
+// SetTrustedProxies configures the set of CIDRs (and/or individual IPs)
+// that are trusted to set the X-Forwarded-For, X-Real-IP and Forwarded
+// headers. Requests whose RemoteAddr does not match any entry have these
+// headers ignored, so GetSourceIPFromHeaders/GetSourceIPRaw/GetSourceIP
+// fall back to the TCP-level RemoteAddr instead.
+//
+// Passing an empty list disables the check, i.e. the headers are honored
+// unconditionally - this matches the behavior prior to trusted proxies
+// being configurable and remains the default.
+func SetTrustedProxies(cidrs []string) error {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy '%s': not a CIDR or IP address", cidr)
+			}
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		}
+		nets = append(nets, ipNet)
+	}
+
+	trustedProxiesMu.Lock()
+	trustedProxies = nets
+	trustedProxiesMu.Unlock()
+	return nil
+}
+
+func isTrustedProxy(remoteAddr string) bool {
+	trustedProxiesMu.RLock()
+	nets := trustedProxies
+	trustedProxiesMu.RUnlock()
+
+	if len(nets) == 0 {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSourceIPFromHeaders retrieves the IP from the X-Forwarded-For, X-Real-IP
-// and RFC7239 Forwarded headers (in that order)
+// and RFC7239 Forwarded headers (in that order). If trusted proxies have
+// been configured via SetTrustedProxies and the request's RemoteAddr does
+// not match any of them, the headers are ignored and an empty string is
+// returned so callers fall back to RemoteAddr.
 func GetSourceIPFromHeaders(r *http.Request) string {
+	if !isTrustedProxy(r.RemoteAddr) {
+		return ""
+	}
+
 	var addr string
 
 	if fwd := r.Header.Get(xForwardedFor); fwd != "" {
@@ -84,3 +84,38 @@ func TestGetSourceIP(t *testing.T) {
 		}
 	}
 }
+
+// TestTrustedProxies - verifies that X-Forwarded-For is only honored when
+// the request's RemoteAddr matches a configured trusted proxy.
+func TestTrustedProxies(t *testing.T) {
+	defer SetTrustedProxies(nil)
+
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		remoteAddr string
+		expected   string
+	}{
+		{"10.1.2.3:1234", "8.8.8.8"},    // trusted via CIDR
+		{"192.168.1.1:1234", "8.8.8.8"}, // trusted via exact IP
+		{"203.0.113.5:1234", "203.0.113.5"},
+	}
+
+	for _, v := range tests {
+		req := &http.Request{
+			RemoteAddr: v.remoteAddr,
+			Header: http.Header{
+				xForwardedFor: []string{"8.8.8.8"},
+			},
+		}
+		if res := GetSourceIPRaw(req); res != v.expected {
+			t.Errorf("remoteAddr %s: got %s want %s", v.remoteAddr, res, v.expected)
+		}
+	}
+
+	if err := SetTrustedProxies([]string{"not-a-cidr-or-ip"}); err == nil {
+		t.Error("expected error for invalid trusted proxy entry")
+	}
+}
@@ -39,6 +39,12 @@ import (
 // If the reference values for the ETag and content SHA26
 // are not empty then it will check whether the computed
 // match the reference values.
+//
+// Since object layer backends (FS and erasure/XL) stream data straight from
+// this Reader into the storage writer, a mismatch detected on the final Read
+// call propagates directly as the error returned from PutObject/PutObjectPart
+// for that backend, which aborts the write and cleans up any partial data -
+// this is not a gateway-only concern.
 type Reader struct {
 	src         io.Reader
 	bytesRead   int64
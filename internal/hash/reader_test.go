@@ -29,6 +29,29 @@ import (
 	"github.com/minio/minio/internal/ioutil"
 )
 
+// TestHashReaderStreamingAbortsOnMismatch verifies that a digest mismatch is
+// surfaced as soon as the underlying stream is exhausted, without the Reader
+// having buffered the content itself - this is what lets a caller that
+// streams straight from the Reader into storage (as PutObject/PutObjectPart
+// do for FS and erasure/XL) abort the write instead of committing bad data.
+func TestHashReaderStreamingAbortsOnMismatch(t *testing.T) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("abcd"))
+		pw.Close()
+	}()
+
+	r, err := NewReader(context.Background(), pr, 4, "d41d8cd98f00b204e9800998ecf8427f", "", 4)
+	if err != nil {
+		t.Fatalf("unexpected error creating reader: %v", err)
+	}
+
+	_, err = io.Copy(io.Discard, r)
+	if _, ok := err.(BadDigest); !ok {
+		t.Fatalf("expected BadDigest error, got %v", err)
+	}
+}
+
 // Tests functions like Size(), MD5*(), SHA256*()
 func TestHashReaderHelperMethods(t *testing.T) {
 	r, err := NewReader(context.Background(), bytes.NewReader([]byte("abcd")), 4, "e2fc714c4727ee9395f324cd2e7f331f", "88d4266fd4e6338d13b845fcf289579d209c897823b9217da3e161936f031589", 4)
@@ -202,6 +202,15 @@ const (
 	// Reports number of drives currently healing
 	MinIOHealingDrives = "x-minio-healing-drives"
 
+	// Set on a listing response if the request opted into best-effort
+	// listing and fewer than quorum drives were reachable, meaning the
+	// result may be missing entries that only exist on unreachable drives.
+	MinIODegradedListing = "x-minio-degraded-listing"
+
+	// Reports the erasure data and parity block counts the object is
+	// currently stored with, as "<data>,<parity>".
+	MinIOErasureDataParity = "x-minio-erasure-data-parity"
+
 	// Header indicates if the delete marker should be preserved by client
 	MinIOSourceDeleteMarker = "x-minio-source-deletemarker"
 
@@ -243,6 +252,20 @@ const (
 	// MinIOCompressed is returned when object is compressed
 	MinIOCompressed = "X-Minio-Compressed"
 
+	// MinIOAppendObject, set to "true", requests the non-standard MinIO
+	// append-object extension on a PutObject call, appending the request
+	// body to an existing object instead of replacing it.
+	MinIOAppendObject = "X-Minio-Append-Object"
+
+	// MinIOShardCheck, set to "true" on a HeadObject request, requests a
+	// quick shard-presence check (a dry-run heal) of the object across all
+	// drives in addition to the usual metadata lookup, so monitors can
+	// detect at-risk objects without running a full heal.
+	MinIOShardCheck = "X-Minio-Shard-Check"
+	// MinIOShardsOnline reports the outcome of MinIOShardCheck as
+	// "<online>/<total>" erasure shards currently readable for the object.
+	MinIOShardsOnline = "X-Minio-Shards-Online"
+
 	// SUBNET related
 	SubnetAPIKey = "x-subnet-api-key"
 )
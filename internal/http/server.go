@@ -59,6 +59,7 @@ type Server struct {
 	listener      *httpListener // HTTP listener for all 'Addrs' field.
 	inShutdown    uint32        // indicates whether the server is in shutdown or not
 	requestCount  int32         // counter holds no. of request in progress.
+	openConns     int32         // counter holds no. of open client connections.
 }
 
 // GetRequestCount - returns number of request in progress.
@@ -66,6 +67,12 @@ func (srv *Server) GetRequestCount() int {
 	return int(atomic.LoadInt32(&srv.requestCount))
 }
 
+// GetOpenConnectionCount - returns number of open client connections, including idle
+// keep-alive connections that are not currently serving a request.
+func (srv *Server) GetOpenConnectionCount() int {
+	return int(atomic.LoadInt32(&srv.openConns))
+}
+
 // Init - init HTTP server
 func (srv *Server) Init(listenCtx context.Context, listenErrCallback func(listenAddr string, err error)) (serve func() error, err error) {
 	// Take a copy of server fields.
@@ -118,6 +125,17 @@ func (srv *Server) Init(listenCtx context.Context, listenErrCallback func(listen
 		handler.ServeHTTP(w, r)
 	})
 
+	// Track the number of open client connections (including idle keep-alive
+	// connections) for use in connection draining and autoscaling decisions.
+	srv.ConnState = func(conn net.Conn, state http.ConnState) {
+		switch state {
+		case http.StateNew:
+			atomic.AddInt32(&srv.openConns, 1)
+		case http.StateClosed, http.StateHijacked:
+			atomic.AddInt32(&srv.openConns, -1)
+		}
+	}
+
 	srv.listenerMutex.Lock()
 	srv.Handler = wrappedHandler
 	srv.listener = listener
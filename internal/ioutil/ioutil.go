@@ -308,6 +308,16 @@ type writerOnly struct {
 }
 
 // Copy is exactly like io.Copy but with reusable buffers.
+//
+// This intentionally goes through a user-space buffer on every call - there
+// is no FS-backend/sendfile fast path to take here, because every object is
+// stored as one or more bitrot-hashed parts (even with a single drive and no
+// erasure parity) and each part has to be read, hashed and verified before
+// its bytes can be considered safe to return to a client. That verification
+// step requires the data to pass through user space regardless of backend,
+// so wrapping dst in writerOnly below to hide any ReadFrom method it may
+// have (and with it, any sendfile/splice optimization the standard library
+// might otherwise attempt) costs nothing extra in practice.
 func Copy(dst io.Writer, src io.Reader) (written int64, err error) {
 	bufp := ODirectPoolMedium.Get().(*[]byte)
 	defer ODirectPoolMedium.Put(bufp)
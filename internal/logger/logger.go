@@ -30,6 +30,7 @@ import (
 	"runtime"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/highwayhash"
@@ -107,6 +108,22 @@ func IsQuiet() bool {
 	return quietFlag
 }
 
+// logMask holds the currently active madmin.LogMask, as a uint64 so it can
+// be read/written without a lock from the hot logging path. Defaults to
+// LogMaskAll so logging behaves as before until an operator narrows it.
+var logMask uint64 = madmin.LogMaskAll.Mask()
+
+// SetLogMask updates the kinds of logs that are sent to configured targets.
+// It takes effect immediately for subsequent log calls, without a restart.
+func SetLogMask(mask madmin.LogMask) {
+	atomic.StoreUint64(&logMask, mask.Mask())
+}
+
+// LogMask returns the currently active log mask.
+func LogMask() madmin.LogMask {
+	return madmin.LogMask(atomic.LoadUint64(&logMask))
+}
+
 // RegisterError registers the specified rendering function. This latter
 // will be called for a pretty rendering of fatal errors.
 func RegisterError(f func(string, error, bool) string) {
@@ -392,6 +409,9 @@ func consoleLogIf(ctx context.Context, subsystem string, err error, errKind ...i
 	if err == nil {
 		return
 	}
+	if !LogMask().Contains(logKindFromArgs(errKind...).LogMask()) {
+		return
+	}
 	if consoleTgt != nil {
 		entry := errToEntry(ctx, subsystem, err, errKind...)
 		consoleTgt.Send(ctx, entry)
@@ -407,10 +427,25 @@ func logIf(ctx context.Context, subsystem string, err error, errKind ...interfac
 	if err == nil {
 		return
 	}
+	if !LogMask().Contains(logKindFromArgs(errKind...).LogMask()) {
+		return
+	}
 	entry := errToEntry(ctx, subsystem, err, errKind...)
 	sendLog(ctx, entry)
 }
 
+// logKindFromArgs mirrors the errKind resolution in buildLogEntry, so
+// masking decisions are made consistently with the kind that will
+// eventually be attached to the log entry.
+func logKindFromArgs(errKind ...interface{}) madmin.LogKind {
+	if len(errKind) > 0 {
+		if ek, ok := errKind[0].(madmin.LogKind); ok {
+			return ek
+		}
+	}
+	return madmin.LogKindError
+}
+
 func sendLog(ctx context.Context, entry log.Entry) {
 	systemTgts := SystemTargets()
 	if len(systemTgts) == 0 {